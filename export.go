@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/innovationnorway/terraform-provider-netbox/netbox"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// runExport implements the export subcommand. The provider is configured
+// from the NETBOX_* environment variables.
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	dir := flags.String("dir", ".", "directory the generated .tf files are written to")
+	types := flags.String("types", "", "comma-separated resource types to export, one of: "+strings.Join(netbox.ExportTypes(), ", "))
+
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [options]\n\n", "terraform-provider-netbox")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var selected []string
+	if *types != "" {
+		selected = strings.Split(*types, ",")
+	}
+
+	ctx := context.Background()
+
+	p := netbox.Provider()
+	if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(nil)); diags.HasError() {
+		return fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+	}
+
+	return netbox.Export(ctx, p.Meta().(*client.NetBoxAPI), *dir, selected)
+}
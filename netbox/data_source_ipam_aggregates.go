@@ -92,7 +92,7 @@ func dataSourceIpamAggregatesRead(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := c.Ipam.IpamAggregatesList(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to get aggregates: %v", err)
+		return apiErrorDiags("Unable to get aggregates", err, nil)
 	}
 
 	//lintignore:R017
@@ -78,7 +78,11 @@ func dataSourceIpamAvailablePrefixesRead(ctx context.Context, d *schema.Resource
 
 	resp, err := c.Ipam.IpamPrefixesAvailablePrefixesRead(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to get available prefixes: %v", err)
+		if isNotFound(err) {
+			return diag.Errorf("Unable to find prefix with ID %d", id)
+		}
+
+		return apiErrorDiags("Unable to get available prefixes", err, nil)
 	}
 
 	d.SetId(string(rune(id)))
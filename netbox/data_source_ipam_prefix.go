@@ -226,7 +226,11 @@ func dataSourceIpamPrefixRead(ctx context.Context, d *schema.ResourceData, m int
 
 	resp, err := c.Ipam.IpamPrefixesRead(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to get prefix: %v", err)
+		if isNotFound(err) {
+			return diag.Errorf("Unable to find prefix with ID %d", params.ID)
+		}
+
+		return apiErrorDiags("Unable to get prefix", err, nil)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -241,7 +245,7 @@ func dataSourceIpamPrefixRead(ctx context.Context, d *schema.ResourceData, m int
 	d.Set("is_pool", resp.Payload.IsPool)
 	d.Set("description", resp.Payload.Description)
 	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
 
 	return diags
 }
@@ -371,7 +371,7 @@ func dataSourceIpamPrefixesRead(ctx context.Context, d *schema.ResourceData, m i
 
 	resp, err := c.Ipam.IpamPrefixesList(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to get prefixes: %v", err)
+		return apiErrorDiags("Unable to get prefixes", err, nil)
 	}
 
 	//lintignore:R017
@@ -0,0 +1,69 @@
+package netbox
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/ipam"
+)
+
+func dataSourceIpamRole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIpamRoleRead,
+		Schema: map[string]*schema.Schema{
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"weight": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIpamRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	slug := d.Get("slug").(string)
+
+	params := &ipam.IpamRolesListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Ipam.IpamRolesList(params, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to get role", err, nil)
+	}
+
+	if len(resp.Payload.Results) != 1 {
+		return diag.Errorf("Unable to find role with slug %q", slug)
+	}
+
+	role := resp.Payload.Results[0]
+
+	d.SetId(strconv.FormatInt(role.ID, 10))
+	d.Set("name", role.Name)
+	d.Set("weight", role.Weight)
+	d.Set("description", role.Description)
+
+	return diags
+}
@@ -0,0 +1,43 @@
+package netbox
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIpamRole_basic(t *testing.T) {
+	slug := "test-role-data-source"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceIpamRoleConfig(slug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netbox_ipam_role.test", "id", "netbox_ipam_role.test", "id"),
+					resource.TestCheckResourceAttr("data.netbox_ipam_role.test", "name", "test role data source"),
+					resource.TestCheckResourceAttr("data.netbox_ipam_role.test", "weight", "200"),
+					resource.TestCheckResourceAttr("data.netbox_ipam_role.test", "description", "Acceptance test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceIpamRoleConfig(slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_ipam_role" "test" {
+  name        = "test role data source"
+  slug        = "%s"
+  weight      = 200
+  description = "Acceptance test"
+}
+
+data "netbox_ipam_role" "test" {
+  slug = netbox_ipam_role.test.slug
+}
+`, slug)
+}
@@ -0,0 +1,237 @@
+package netbox
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/tenancy"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+// tenancyTenantGroupsPageSize is the number of objects requested per page
+// when listing tenant groups and tenants.
+const tenancyTenantGroupsPageSize = 100
+
+func dataSourceTenancyTenantGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTenancyTenantGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"parent_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"depth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"path": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"child_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+
+						"tenants": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"slug": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTenancyTenantGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	limit := int64(tenancyTenantGroupsPageSize)
+
+	var groups []*models.TenantGroup
+
+	for offset := int64(0); ; {
+		params := &tenancy.TenancyTenantGroupsListParams{
+			Context: ctx,
+			Limit:   &limit,
+			Offset:  &offset,
+		}
+
+		resp, err := c.Tenancy.TenancyTenantGroupsList(params, nil)
+		if err != nil {
+			return apiErrorDiags("Unable to get tenant groups", err, nil)
+		}
+
+		groups = append(groups, resp.Payload.Results...)
+
+		offset += int64(len(resp.Payload.Results))
+		if len(resp.Payload.Results) == 0 || offset >= *resp.Payload.Count {
+			break
+		}
+	}
+
+	var tenants []*models.Tenant
+
+	for offset := int64(0); ; {
+		params := &tenancy.TenancyTenantsListParams{
+			Context: ctx,
+			Limit:   &limit,
+			Offset:  &offset,
+		}
+
+		resp, err := c.Tenancy.TenancyTenantsList(params, nil)
+		if err != nil {
+			return apiErrorDiags("Unable to get tenants", err, nil)
+		}
+
+		tenants = append(tenants, resp.Payload.Results...)
+
+		offset += int64(len(resp.Payload.Results))
+		if len(resp.Payload.Results) == 0 || offset >= *resp.Payload.Count {
+			break
+		}
+	}
+
+	//lintignore:R017
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	d.Set("groups", flattenTenancyTenantGroupTree(groups, tenants))
+
+	return diags
+}
+
+// flattenTenancyTenantGroupTree returns the tenant groups in depth-first
+// order, each followed by its children sorted by name, so that every group
+// comes after its parent. Groups carry their depth, the slugs of the groups
+// leading to them and the tenants assigned directly to them.
+func flattenTenancyTenantGroupTree(groups []*models.TenantGroup, tenants []*models.Tenant) []interface{} {
+	known := make(map[int64]bool, len(groups))
+	for _, g := range groups {
+		known[g.ID] = true
+	}
+
+	children := make(map[int64][]*models.TenantGroup)
+	for _, g := range groups {
+		var parentID int64
+		if g.Parent != nil && known[g.Parent.ID] {
+			parentID = g.Parent.ID
+		}
+
+		children[parentID] = append(children[parentID], g)
+	}
+
+	for _, v := range children {
+		sort.Slice(v, func(i, j int) bool {
+			return *v[i].Name < *v[j].Name
+		})
+	}
+
+	members := make(map[int64][]interface{})
+	for _, t := range tenants {
+		if t.Group == nil {
+			continue
+		}
+
+		members[t.Group.ID] = append(members[t.Group.ID], map[string]interface{}{
+			"id":   t.ID,
+			"name": *t.Name,
+			"slug": *t.Slug,
+		})
+	}
+
+	result := make([]interface{}, 0, len(groups))
+
+	var walk func(parentID int64, path []interface{})
+	walk = func(parentID int64, path []interface{}) {
+		for _, g := range children[parentID] {
+			childIDs := make([]interface{}, 0, len(children[g.ID]))
+			for _, child := range children[g.ID] {
+				childIDs = append(childIDs, child.ID)
+			}
+
+			groupPath := append(append([]interface{}{}, path...), *g.Slug)
+
+			groupTenants := members[g.ID]
+			if groupTenants == nil {
+				groupTenants = []interface{}{}
+			}
+
+			result = append(result, map[string]interface{}{
+				"id":          g.ID,
+				"name":        *g.Name,
+				"slug":        *g.Slug,
+				"description": g.Description,
+				"parent_id":   parentID,
+				"depth":       len(path),
+				"path":        groupPath,
+				"child_ids":   childIDs,
+				"tenants":     groupTenants,
+			})
+
+			walk(g.ID, groupPath)
+		}
+	}
+
+	walk(0, []interface{}{})
+
+	return result
+}
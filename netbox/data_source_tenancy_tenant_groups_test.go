@@ -0,0 +1,126 @@
+package netbox
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+func TestFlattenTenancyTenantGroupTree(t *testing.T) {
+	group := func(id int64, name string, parent int64) *models.TenantGroup {
+		g := &models.TenantGroup{ID: id, Name: &name, Slug: &name}
+		if parent != 0 {
+			g.Parent = &models.NestedTenantGroup{ID: parent}
+		}
+
+		return g
+	}
+
+	tenant := func(id int64, name string, group int64) *models.Tenant {
+		t := &models.Tenant{ID: id, Name: &name, Slug: &name}
+		if group != 0 {
+			t.Group = &models.NestedTenantGroup{ID: group}
+		}
+
+		return t
+	}
+
+	groups := []*models.TenantGroup{
+		group(3, "gold", 1),
+		group(1, "customers", 0),
+		group(4, "bronze", 1),
+		group(2, "internal", 0),
+		group(5, "eu", 3),
+	}
+
+	tenants := []*models.Tenant{
+		tenant(10, "acme", 5),
+		tenant(11, "initech", 4),
+		tenant(12, "ops", 0),
+	}
+
+	result := flattenTenancyTenantGroupTree(groups, tenants)
+
+	var slugs []string
+	for _, v := range result {
+		slugs = append(slugs, v.(map[string]interface{})["slug"].(string))
+	}
+
+	expected := []string{"customers", "bronze", "gold", "eu", "internal"}
+	if !reflect.DeepEqual(slugs, expected) {
+		t.Fatalf("expected groups in order %v, got %v", expected, slugs)
+	}
+
+	eu := result[3].(map[string]interface{})
+
+	if eu["parent_id"] != int64(3) || eu["depth"] != 2 {
+		t.Fatalf("unexpected parent or depth %#v", eu)
+	}
+
+	if !reflect.DeepEqual(eu["path"], []interface{}{"customers", "gold", "eu"}) {
+		t.Fatalf("unexpected path %#v", eu["path"])
+	}
+
+	if tenants := eu["tenants"].([]interface{}); len(tenants) != 1 || tenants[0].(map[string]interface{})["slug"] != "acme" {
+		t.Fatalf("unexpected tenants %#v", tenants)
+	}
+
+	customers := result[0].(map[string]interface{})
+
+	if !reflect.DeepEqual(customers["child_ids"], []interface{}{int64(4), int64(3)}) {
+		t.Fatalf("unexpected child IDs %#v", customers["child_ids"])
+	}
+
+	if tenants := customers["tenants"].([]interface{}); len(tenants) != 0 {
+		t.Fatalf("expected no tenants directly in customers, got %#v", tenants)
+	}
+}
+
+func TestAccDataSourceTenancyTenantGroups_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTenancyTenantGroupsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.netbox_tenancy_tenant_groups.test", "groups.*", map[string]string{
+						"slug":           "test-tenant-groups-child",
+						"depth":          "1",
+						"path.0":         "test-tenant-groups",
+						"path.1":         "test-tenant-groups-child",
+						"tenants.#":      "1",
+						"tenants.0.slug": "test-tenant-groups",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceTenancyTenantGroupsConfig = `
+resource "netbox_tenancy_tenant_group" "test-tenant-groups" {
+  name = "test-tenant-groups"
+  slug = "test-tenant-groups"
+}
+
+resource "netbox_tenancy_tenant_group" "test-tenant-groups-child" {
+  name      = "test-tenant-groups-child"
+  slug      = "test-tenant-groups-child"
+  parent_id = netbox_tenancy_tenant_group.test-tenant-groups.id
+}
+
+resource "netbox_tenancy_tenant" "test-tenant-groups" {
+  name     = "test-tenant-groups"
+  slug     = "test-tenant-groups"
+  group_id = netbox_tenancy_tenant_group.test-tenant-groups-child.id
+}
+
+data "netbox_tenancy_tenant_groups" "test" {
+  depends_on = [
+    netbox_tenancy_tenant.test-tenant-groups,
+  ]
+}
+`
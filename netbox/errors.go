@@ -0,0 +1,279 @@
+package netbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type errorKind int
+
+const (
+	errorKindUnknown errorKind = iota
+	errorKindNotFound
+	errorKindConflict
+	errorKindPermissionDenied
+	errorKindValidation
+	errorKindTransient
+)
+
+// classifyError works out what kind of failure err describes without
+// assuming a concrete type, since the go-netbox client returns
+// *runtime.APIError, typed responses or plain transport errors depending on
+// the operation and on where the request failed.
+func classifyError(err error) errorKind {
+	if err == nil {
+		return errorKindUnknown
+	}
+
+	if code, ok := errorStatusCode(err); ok {
+		switch {
+		case code == http.StatusNotFound:
+			return errorKindNotFound
+		case code == http.StatusConflict:
+			return errorKindConflict
+		case code == http.StatusUnauthorized, code == http.StatusForbidden:
+			return errorKindPermissionDenied
+		case code == http.StatusBadRequest:
+			return errorKindValidation
+		case code == http.StatusTooManyRequests, code >= 500:
+			return errorKindTransient
+		}
+
+		return errorKindUnknown
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return errorKindTransient
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return errorKindTransient
+	}
+
+	// Refused and reset connections surface as *net.OpError.
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return errorKindTransient
+	}
+
+	return errorKindUnknown
+}
+
+func isNotFound(err error) bool {
+	return classifyError(err) == errorKindNotFound
+}
+
+// errorStatusCode returns the HTTP status code of a rejected request. The
+// go-netbox client reports unexpected responses as *runtime.APIError and the
+// responses declared for an operation, like netboxRequest errors, carry a
+// Code method.
+func errorStatusCode(err error) (int, bool) {
+	var apiErr *runtime.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code, true
+	}
+
+	var coder interface{ Code() int }
+	if errors.As(err, &coder) {
+		return coder.Code(), true
+	}
+
+	return 0, false
+}
+
+// errorDetail describes err and, for failures that are not obvious from the
+// error text, what is likely to be wrong.
+func errorDetail(err error) string {
+	switch classifyError(err) {
+	case errorKindPermissionDenied:
+		return fmt.Sprintf("%s\n\nCheck that the API token is valid and has permission for this operation.", err)
+	case errorKindConflict:
+		return fmt.Sprintf("%s\n\nThe object conflicts with the current state in Netbox, for example because other objects still depend on it.", err)
+	case errorKindTransient:
+		return fmt.Sprintf("%s\n\nNetbox did not respond in time or is temporarily unavailable. Retrying the operation may succeed.", err)
+	}
+
+	return err.Error()
+}
+
+// netboxErrorResponse is implemented by the go-netbox "Default" responses,
+// which carry the decoded JSON body of a rejected request.
+type netboxErrorResponse interface {
+	Code() int
+	GetPayload() interface{}
+}
+
+// fieldAliases maps NetBox field names to the attribute that sets them when
+// the names differ by more than an "_id" suffix.
+var fieldAliases = map[string]string{
+	"asn":          "asn_id",
+	"facility_id":  "facility",
+	"mgmt_only":    "management_only",
+	"tagged_vlans": "tagged_vlan",
+}
+
+// nonFieldErrorKeys are the keys NetBox uses for errors that do not belong to
+// a single field.
+var nonFieldErrorKeys = map[string]bool{
+	"__all__":          true,
+	"detail":           true,
+	"non_field_errors": true,
+}
+
+// apiErrorDiags turns an error returned by a go-netbox operation into
+// diagnostics. Field-keyed validation errors become one diagnostic per field,
+// pointing at the attribute in s that sets the field. s may be nil when the
+// operation does not write any attributes.
+func apiErrorDiags(summary string, err error, s map[string]*schema.Schema) diag.Diagnostics {
+	var resp netboxErrorResponse
+	if !errors.As(err, &resp) {
+		return diag.Diagnostics{errorDiag(summary, errorDetail(err), nil)}
+	}
+
+	fields, ok := resp.GetPayload().(map[string]interface{})
+	if !ok || len(fields) == 0 {
+		return diag.Diagnostics{errorDiag(summary, errorDetail(err), nil)}
+	}
+
+	var diags diag.Diagnostics
+
+	for _, field := range sortedKeys(fields) {
+		if nonFieldErrorKeys[field] {
+			diags = append(diags, errorDiag(summary, errorMessages(fields[field]), nil))
+			continue
+		}
+
+		attribute, ok := attributeForField(field, s)
+		if !ok {
+			diags = append(diags, errorDiag(summary, fmt.Sprintf("%s: %s", field, errorMessages(fields[field])), nil))
+			continue
+		}
+
+		diags = append(diags, fieldErrorDiags(summary, fields[field], cty.GetAttrPath(attribute), s[attribute])...)
+	}
+
+	return diags
+}
+
+func attributeForField(field string, s map[string]*schema.Schema) (string, bool) {
+	if _, ok := s[field]; ok {
+		return field, true
+	}
+
+	if alias, ok := fieldAliases[field]; ok {
+		if _, ok := s[alias]; ok {
+			return alias, true
+		}
+	}
+
+	if _, ok := s[field+"_id"]; ok {
+		return field + "_id", true
+	}
+
+	return "", false
+}
+
+// fieldErrorDiags follows nested serializer errors, such as a rejected tag in
+// the tags list, down to the attribute that caused them where possible.
+func fieldErrorDiags(summary string, value interface{}, path cty.Path, s *schema.Schema) diag.Diagnostics {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		var diags diag.Diagnostics
+
+		for _, key := range sortedKeys(v) {
+			switch elem := s.Elem.(type) {
+			case *schema.Resource:
+				if nested, ok := elem.Schema[key]; ok {
+					diags = append(diags, fieldErrorDiags(summary, v[key], path.GetAttr(key), nested)...)
+					continue
+				}
+			default:
+				if s.Type == schema.TypeMap {
+					diags = append(diags, errorDiag(summary, errorMessages(v[key]), path.IndexString(key)))
+					continue
+				}
+			}
+
+			diags = append(diags, errorDiag(summary, fmt.Sprintf("%s: %s", key, errorMessages(v[key])), path))
+		}
+
+		return diags
+	case []interface{}:
+		if _, ok := s.Elem.(*schema.Resource); ok && containsMaps(v) {
+			var diags diag.Diagnostics
+
+			for i, item := range v {
+				if m, ok := item.(map[string]interface{}); ok && len(m) > 0 {
+					diags = append(diags, fieldErrorDiags(summary, m, path.IndexInt(i), s)...)
+				}
+			}
+
+			return diags
+		}
+	}
+
+	return diag.Diagnostics{errorDiag(summary, errorMessages(value), path)}
+}
+
+func errorDiag(summary, detail string, path cty.Path) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       summary,
+		Detail:        detail,
+		AttributePath: path,
+	}
+}
+
+func errorMessages(value interface{}) string {
+	switch v := value.(type) {
+	case []interface{}:
+		messages := make([]string, 0, len(v))
+		for _, item := range v {
+			messages = append(messages, errorMessages(item))
+		}
+
+		return strings.Join(messages, " ")
+	case map[string]interface{}:
+		messages := make([]string, 0, len(v))
+		for _, key := range sortedKeys(v) {
+			messages = append(messages, fmt.Sprintf("%s: %s", key, errorMessages(v[key])))
+		}
+
+		return strings.Join(messages, " ")
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func containsMaps(input []interface{}) bool {
+	for _, item := range input {
+		if _, ok := item.(map[string]interface{}); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(input map[string]interface{}) []string {
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
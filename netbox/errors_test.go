@@ -0,0 +1,255 @@
+package netbox
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/client/ipam"
+)
+
+// configFileNotFound is unrelated to the NetBox API and must not be taken for
+// a 404 response because of its name.
+type configFileNotFound struct{}
+
+func (o *configFileNotFound) Error() string {
+	return "config file not found"
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestClassifyError(t *testing.T) {
+	conflict := dcim.NewDcimRacksPartialUpdateDefault(409)
+	validation := dcim.NewDcimRacksCreateDefault(400)
+	validation.Payload = map[string]interface{}{"name": []interface{}{"This field is required."}}
+
+	cases := map[string]struct {
+		err  error
+		kind errorKind
+	}{
+		"api error 404":         {runtime.NewAPIError("unknown error", nil, 404), errorKindNotFound},
+		"wrapped api error 404": {fmt.Errorf("read failed: %w", runtime.NewAPIError("unknown error", nil, 404)), errorKindNotFound},
+		"request error 404":     {&netboxRequestError{method: "GET", path: "/dcim/racks/1/", code: 404}, errorKindNotFound},
+		"named not found":       {&configFileNotFound{}, errorKindUnknown},
+		"default 409":           {conflict, errorKindConflict},
+		"api error 401":         {runtime.NewAPIError("unknown error", nil, 401), errorKindPermissionDenied},
+		"api error 403":         {runtime.NewAPIError("unknown error", nil, 403), errorKindPermissionDenied},
+		"default 400":           {validation, errorKindValidation},
+		"api error 429":         {runtime.NewAPIError("unknown error", nil, 429), errorKindTransient},
+		"api error 502":         {runtime.NewAPIError("unknown error", nil, 502), errorKindTransient},
+		"api error 418":         {runtime.NewAPIError("unknown error", nil, 418), errorKindUnknown},
+		"timeout": {
+			&url.Error{Op: "Get", URL: "https://netbox/api/dcim/racks/1/", Err: timeoutError{}},
+			errorKindTransient,
+		},
+		"deadline exceeded": {
+			&url.Error{Op: "Get", URL: "https://netbox/api/dcim/racks/1/", Err: context.DeadlineExceeded},
+			errorKindTransient,
+		},
+		"connection refused": {
+			&url.Error{Op: "Get", URL: "https://netbox/api/dcim/racks/1/", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}},
+			errorKindTransient,
+		},
+		"connection reset": {
+			&url.Error{Op: "Get", URL: "https://netbox/api/dcim/racks/1/", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}},
+			errorKindTransient,
+		},
+		"unexpected eof": {
+			&url.Error{Op: "Get", URL: "https://netbox/api/dcim/racks/1/", Err: io.ErrUnexpectedEOF},
+			errorKindTransient,
+		},
+		"context canceled": {
+			&url.Error{Op: "Get", URL: "https://netbox/api/dcim/racks/1/", Err: context.Canceled},
+			errorKindUnknown,
+		},
+		"certificate error": {
+			&url.Error{Op: "Get", URL: "https://netbox/api/dcim/racks/1/", Err: x509.UnknownAuthorityError{}},
+			errorKindUnknown,
+		},
+		"plain error": {errors.New("boom"), errorKindUnknown},
+		"nil":         {nil, errorKindUnknown},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if kind := classifyError(tc.err); kind != tc.kind {
+				t.Fatalf("expected kind %d, got %d", tc.kind, kind)
+			}
+
+			if isNotFound(tc.err) != (tc.kind == errorKindNotFound) {
+				t.Fatalf("unexpected isNotFound result for %v", tc.err)
+			}
+		})
+	}
+}
+
+func TestErrorDetail(t *testing.T) {
+	err := runtime.NewAPIError("unknown error", nil, 403)
+
+	if detail := errorDetail(err); !strings.HasPrefix(detail, err.Error()) || !strings.Contains(detail, "API token") {
+		t.Fatalf("expected the detail to explain the permission error, got %q", detail)
+	}
+
+	plain := errors.New("boom")
+	if detail := errorDetail(plain); detail != "boom" {
+		t.Fatalf("expected %q, got %q", "boom", detail)
+	}
+}
+
+func TestApiErrorDiags_fields(t *testing.T) {
+	err := dcim.NewDcimRacksCreateDefault(400)
+	err.Payload = map[string]interface{}{
+		"site":        []interface{}{"This field is required."},
+		"facility_id": []interface{}{"Ensure this field has no more than 50 characters."},
+		"u_height":    []interface{}{"Ensure this value is less than or equal to 100."},
+		"tags": []interface{}{
+			map[string]interface{}{},
+			map[string]interface{}{"slug": []interface{}{"Related object not found using the provided attributes."}},
+		},
+		"custom_fields": map[string]interface{}{
+			"rackCustomField": []interface{}{"Invalid value."},
+		},
+		"non_field_errors": []interface{}{"The fields name, site must make a unique set."},
+		"location":         []interface{}{"Invalid pk \"3\" - object does not exist."},
+	}
+
+	diags := apiErrorDiags("Unable to create rack", err, resourceDcimRack().Schema)
+
+	expected := diag.Diagnostics{
+		{
+			Severity:      diag.Error,
+			Summary:       "Unable to create rack",
+			Detail:        "Invalid value.",
+			AttributePath: cty.GetAttrPath("custom_fields").IndexString("rackCustomField"),
+		},
+		{
+			Severity:      diag.Error,
+			Summary:       "Unable to create rack",
+			Detail:        "Ensure this field has no more than 50 characters.",
+			AttributePath: cty.GetAttrPath("facility"),
+		},
+		{
+			Severity: diag.Error,
+			Summary:  "Unable to create rack",
+			Detail:   "location: Invalid pk \"3\" - object does not exist.",
+		},
+		{
+			Severity: diag.Error,
+			Summary:  "Unable to create rack",
+			Detail:   "The fields name, site must make a unique set.",
+		},
+		{
+			Severity:      diag.Error,
+			Summary:       "Unable to create rack",
+			Detail:        "This field is required.",
+			AttributePath: cty.GetAttrPath("site_id"),
+		},
+		{
+			Severity:      diag.Error,
+			Summary:       "Unable to create rack",
+			Detail:        "Related object not found using the provided attributes.",
+			AttributePath: cty.GetAttrPath("tags").IndexInt(1).GetAttr("slug"),
+		},
+		{
+			Severity:      diag.Error,
+			Summary:       "Unable to create rack",
+			Detail:        "Ensure this value is less than or equal to 100.",
+			AttributePath: cty.GetAttrPath("u_height"),
+		},
+	}
+
+	assertDiagnostics(t, expected, diags)
+}
+
+func TestApiErrorDiags_aliases(t *testing.T) {
+	err := dcim.NewDcimInterfacesPartialUpdateDefault(400)
+	err.Payload = map[string]interface{}{
+		"mgmt_only":     []interface{}{"Must be a valid boolean."},
+		"tagged_vlans":  []interface{}{"Invalid pk \"12\" - object does not exist."},
+		"untagged_vlan": []interface{}{"Invalid pk \"13\" - object does not exist."},
+	}
+
+	diags := apiErrorDiags("Unable to update interface", err, resourceDcimInterface().Schema)
+
+	expected := diag.Diagnostics{
+		{
+			Severity:      diag.Error,
+			Summary:       "Unable to update interface",
+			Detail:        "Must be a valid boolean.",
+			AttributePath: cty.GetAttrPath("management_only"),
+		},
+		{
+			Severity:      diag.Error,
+			Summary:       "Unable to update interface",
+			Detail:        "Invalid pk \"12\" - object does not exist.",
+			AttributePath: cty.GetAttrPath("tagged_vlan"),
+		},
+		{
+			Severity:      diag.Error,
+			Summary:       "Unable to update interface",
+			Detail:        "Invalid pk \"13\" - object does not exist.",
+			AttributePath: cty.GetAttrPath("untagged_vlan_id"),
+		},
+	}
+
+	assertDiagnostics(t, expected, diags)
+}
+
+func TestApiErrorDiags_unstructured(t *testing.T) {
+	serverError := ipam.NewIpamPrefixesCreateDefault(500)
+	serverError.Payload = "<h1>Server Error (500)</h1>"
+
+	cases := map[string]error{
+		"string payload": serverError,
+		"api error":      runtime.NewAPIError("unknown error", nil, 403),
+		"network error":  errors.New("dial tcp 127.0.0.1:8000: connect: connection refused"),
+	}
+
+	for name, err := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := apiErrorDiags("Unable to create prefix", err, resourceIpamPrefix().Schema)
+
+			expected := diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "Unable to create prefix",
+					Detail:   errorDetail(err),
+				},
+			}
+
+			assertDiagnostics(t, expected, diags)
+		})
+	}
+}
+
+func assertDiagnostics(t *testing.T, expected, actual diag.Diagnostics) {
+	t.Helper()
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d diagnostics, got %d: %#v", len(expected), len(actual), actual)
+	}
+
+	for i := range expected {
+		if actual[i].Severity != expected[i].Severity ||
+			actual[i].Summary != expected[i].Summary ||
+			actual[i].Detail != expected[i].Detail ||
+			!actual[i].AttributePath.Equals(expected[i].AttributePath) {
+			t.Errorf("diagnostic %d:\nexpected %#v\ngot      %#v", i, expected[i], actual[i])
+		}
+	}
+}
@@ -0,0 +1,497 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// exportPageSize is the number of objects requested per page when listing.
+const exportPageSize = 100
+
+// exportListFunc returns one page of object IDs along with the total number of
+// objects of that type.
+type exportListFunc func(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error)
+
+// exportType describes how objects of one resource type are enumerated and
+// rendered by Export.
+type exportType struct {
+	name     string
+	resource func() *schema.Resource
+	list     exportListFunc
+
+	// label lists the attributes the generated resource name is built from.
+	// Attributes referencing another exported object contribute its name.
+	label []string
+
+	// references maps attributes holding object IDs to the resource type of
+	// the referenced object.
+	references func(d *schema.ResourceData) map[string]string
+
+	// omit lists attributes left out of the generated configuration because
+	// another resource type manages them.
+	omit []string
+}
+
+func (t *exportType) omits(k string) bool {
+	for _, v := range t.omit {
+		if v == k {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exportObject is a single object read from NetBox.
+type exportObject struct {
+	typ   *exportType
+	data  *schema.ResourceData
+	label string
+}
+
+// exportState tracks the objects read so far, keyed by resource type and ID.
+type exportState struct {
+	objects map[string]map[string]*exportObject
+	labels  map[string]map[string]bool
+}
+
+// ExportTypes returns the resource types supported by Export, in the order
+// they are exported.
+func ExportTypes() []string {
+	names := make([]string, 0, len(exportTypes))
+	for _, t := range exportTypes {
+		names = append(names, t.name)
+	}
+
+	return names
+}
+
+// Export reads every object of the given resource types from NetBox and
+// writes one <type>.tf file per type to dir. Each resource block is preceded
+// by an import block, and attributes referencing other exported objects are
+// written as references instead of IDs. All supported types are exported when
+// types is empty.
+func Export(ctx context.Context, c *client.NetBoxAPI, dir string, types []string) error {
+	selected, err := selectExportTypes(types)
+	if err != nil {
+		return err
+	}
+
+	state := &exportState{
+		objects: make(map[string]map[string]*exportObject),
+		labels:  make(map[string]map[string]bool),
+	}
+
+	for _, t := range selected {
+		if err := state.read(ctx, c, t); err != nil {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for _, t := range selected {
+		objects := state.sorted(t.name)
+		if len(objects) == 0 {
+			continue
+		}
+
+		f := hclwrite.NewEmptyFile()
+		for i, o := range objects {
+			if i > 0 {
+				f.Body().AppendNewline()
+			}
+
+			state.render(f.Body(), o)
+		}
+
+		if err := ioutil.WriteFile(filepath.Join(dir, t.name+".tf"), f.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func selectExportTypes(types []string) ([]*exportType, error) {
+	if len(types) == 0 {
+		return exportTypes, nil
+	}
+
+	wanted := make(map[string]bool)
+	for _, name := range types {
+		wanted[name] = true
+	}
+
+	selected := make([]*exportType, 0, len(types))
+	for _, t := range exportTypes {
+		if wanted[t.name] {
+			selected = append(selected, t)
+			delete(wanted, t.name)
+		}
+	}
+
+	if len(wanted) > 0 {
+		unknown := make([]string, 0, len(wanted))
+		for name := range wanted {
+			unknown = append(unknown, name)
+		}
+		sort.Strings(unknown)
+
+		return nil, fmt.Errorf("Unsupported resource types: %s", strings.Join(unknown, ", "))
+	}
+
+	return selected, nil
+}
+
+// read lists and reads all objects of type t using the resource's own read
+// function, so the exported state matches what an import would produce.
+func (s *exportState) read(ctx context.Context, c *client.NetBoxAPI, t *exportType) error {
+	s.objects[t.name] = make(map[string]*exportObject)
+	s.labels[t.name] = make(map[string]bool)
+
+	r := t.resource()
+
+	for offset := int64(0); ; {
+		ids, count, err := t.list(ctx, c, exportPageSize, offset)
+		if err != nil {
+			return fmt.Errorf("Unable to list %s: %s", t.name, errorDetail(err))
+		}
+
+		for _, id := range ids {
+			d := r.Data(nil)
+			d.SetId(strconv.FormatInt(id, 10))
+
+			if diags := r.ReadContext(ctx, d, c); diags.HasError() {
+				return fmt.Errorf("Unable to read %s %d: %s", t.name, id, diags[0].Summary)
+			}
+
+			if d.Id() == "" {
+				continue
+			}
+
+			o := &exportObject{typ: t, data: d}
+			o.label = s.uniqueLabel(t, s.labelFor(o))
+			s.objects[t.name][d.Id()] = o
+		}
+
+		offset += int64(len(ids))
+		if len(ids) == 0 || offset >= count {
+			return nil
+		}
+	}
+}
+
+func (s *exportState) sorted(name string) []*exportObject {
+	objects := make([]*exportObject, 0, len(s.objects[name]))
+	for _, o := range s.objects[name] {
+		objects = append(objects, o)
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		a, _ := strconv.ParseInt(objects[i].data.Id(), 10, 64)
+		b, _ := strconv.ParseInt(objects[j].data.Id(), 10, 64)
+		return a < b
+	})
+
+	return objects
+}
+
+// lookup returns the exported object of type name with the given ID.
+func (s *exportState) lookup(name string, id interface{}) (*exportObject, bool) {
+	var key string
+
+	switch v := id.(type) {
+	case int:
+		key = strconv.Itoa(v)
+	case string:
+		key = v
+	default:
+		return nil, false
+	}
+
+	o, ok := s.objects[name][key]
+
+	return o, ok
+}
+
+func (s *exportState) labelFor(o *exportObject) string {
+	refs := o.references()
+	parts := make([]string, 0, len(o.typ.label))
+
+	for _, attr := range o.typ.label {
+		v := o.data.Get(attr)
+
+		if target, ok := refs[attr]; ok {
+			if ref, ok := s.lookup(target, v); ok {
+				parts = append(parts, ref.label)
+			}
+			continue
+		}
+
+		if str := fmt.Sprint(v); str != "" && !isZero(v) {
+			parts = append(parts, str)
+		}
+	}
+
+	return sanitizeLabel(strings.Join(parts, "_"))
+}
+
+var labelInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+func sanitizeLabel(label string) string {
+	label = strings.ToLower(label)
+	label = labelInvalidChars.ReplaceAllString(label, "_")
+
+	return strings.Trim(label, "_-")
+}
+
+// uniqueLabel makes label a valid resource name that isn't yet in use for
+// type t.
+func (s *exportState) uniqueLabel(t *exportType, label string) string {
+	short := t.name[strings.LastIndex(t.name, "_")+1:]
+
+	switch {
+	case label == "":
+		label = short
+	case label[0] >= '0' && label[0] <= '9' || label[0] == '-':
+		label = short + "_" + label
+	}
+
+	unique := label
+	for i := 2; s.labels[t.name][unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+
+	s.labels[t.name][unique] = true
+
+	return unique
+}
+
+func (o *exportObject) references() map[string]string {
+	if o.typ.references == nil {
+		return nil
+	}
+
+	return o.typ.references(o.data)
+}
+
+// render appends the import and resource blocks of o to body.
+func (s *exportState) render(body *hclwrite.Body, o *exportObject) {
+	address := hcl.Traversal{
+		hcl.TraverseRoot{Name: o.typ.name},
+		hcl.TraverseAttr{Name: o.label},
+	}
+
+	imp := body.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", address)
+	imp.SetAttributeValue("id", cty.StringVal(o.data.Id()))
+
+	body.AppendNewline()
+
+	res := body.AppendNewBlock("resource", []string{o.typ.name, o.label}).Body()
+	refs := o.references()
+	sm := o.typ.resource().Schema
+
+	for _, k := range sortedSchemaKeys(sm) {
+		if o.typ.omits(k) {
+			continue
+		}
+
+		v := o.data.Get(k)
+
+		if target, ok := refs[k]; ok {
+			if tokens, ok := s.referenceTokens(target, v); ok {
+				res.SetAttributeRaw(k, tokens)
+				continue
+			}
+		}
+
+		writeExportAttribute(res, k, sm[k], v)
+	}
+}
+
+// referenceTokens returns the expression referencing the exported objects
+// identified by v, which is either a single ID or a list or set of IDs. It
+// fails when any of the objects wasn't exported.
+func (s *exportState) referenceTokens(target string, v interface{}) (hclwrite.Tokens, bool) {
+	ref := func(id interface{}) (hclwrite.Tokens, bool) {
+		o, ok := s.lookup(target, id)
+		if !ok {
+			return nil, false
+		}
+
+		return hclwrite.TokensForTraversal(hcl.Traversal{
+			hcl.TraverseRoot{Name: target},
+			hcl.TraverseAttr{Name: o.label},
+			hcl.TraverseAttr{Name: "id"},
+		}), true
+	}
+
+	var items []interface{}
+
+	switch v := v.(type) {
+	case *schema.Set:
+		items = v.List()
+	case []interface{}:
+		items = v
+	default:
+		return ref(v)
+	}
+
+	if len(items) == 0 {
+		return nil, false
+	}
+
+	tokens := hclwrite.Tokens{{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")}}
+	for i, item := range items {
+		if i > 0 {
+			tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")})
+		}
+
+		t, ok := ref(item)
+		if !ok {
+			return nil, false
+		}
+
+		tokens = append(tokens, t...)
+	}
+
+	return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")}), true
+}
+
+// writeExportAttribute writes the attribute k to body unless it is computed
+// only or holds the value NetBox would use anyway.
+func writeExportAttribute(body *hclwrite.Body, k string, s *schema.Schema, v interface{}) {
+	if !s.Optional && !s.Required {
+		return
+	}
+
+	if s.Default != nil {
+		if reflect.DeepEqual(v, s.Default) {
+			return
+		}
+	} else if isZero(v) {
+		return
+	}
+
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		for _, item := range exportItems(v) {
+			block := body.AppendNewBlock(k, nil).Body()
+			values := item.(map[string]interface{})
+
+			for _, nk := range sortedSchemaKeys(r.Schema) {
+				writeExportAttribute(block, nk, r.Schema[nk], values[nk])
+			}
+		}
+
+		return
+	}
+
+	if val, ok := exportValue(s, v); ok {
+		body.SetAttributeValue(k, val)
+	}
+}
+
+func exportItems(v interface{}) []interface{} {
+	if set, ok := v.(*schema.Set); ok {
+		return set.List()
+	}
+
+	items, _ := v.([]interface{})
+
+	return items
+}
+
+// exportValue converts a value read from schema.ResourceData to cty.
+func exportValue(s *schema.Schema, v interface{}) (cty.Value, bool) {
+	switch s.Type {
+	case schema.TypeBool:
+		return cty.BoolVal(v.(bool)), true
+	case schema.TypeInt:
+		return cty.NumberIntVal(int64(v.(int))), true
+	case schema.TypeFloat:
+		return cty.NumberFloatVal(v.(float64)), true
+	case schema.TypeString:
+		return cty.StringVal(v.(string)), true
+	case schema.TypeMap:
+		values := v.(map[string]interface{})
+		elem := &schema.Schema{Type: schema.TypeString}
+		if e, ok := s.Elem.(*schema.Schema); ok {
+			elem = e
+		}
+
+		result := make(map[string]cty.Value, len(values))
+		for mk, mv := range values {
+			val, ok := exportValue(elem, mv)
+			if !ok {
+				return cty.NilVal, false
+			}
+			result[mk] = val
+		}
+
+		return cty.ObjectVal(result), true
+	case schema.TypeList, schema.TypeSet:
+		elem, ok := s.Elem.(*schema.Schema)
+		if !ok {
+			return cty.NilVal, false
+		}
+
+		items := exportItems(v)
+		result := make([]cty.Value, 0, len(items))
+		for _, item := range items {
+			val, ok := exportValue(elem, item)
+			if !ok {
+				return cty.NilVal, false
+			}
+			result = append(result, val)
+		}
+
+		return cty.TupleVal(result), true
+	}
+
+	return cty.NilVal, false
+}
+
+func isZero(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case *schema.Set:
+		return v.Len() == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return reflect.ValueOf(v).IsZero()
+}
+
+func sortedSchemaKeys(s map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,224 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	runtimeclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// exportTestClient returns a client for a fake NetBox serving the given
+// objects, keyed by list path and ID. Lists return a single object per page
+// to exercise pagination.
+func exportTestClient(t *testing.T, objects map[string]map[int64]string) *client.NetBoxAPI {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		dir, last := filepath.Split(strings.TrimSuffix(r.URL.Path, "/"))
+
+		if id, err := strconv.ParseInt(last, 10, 64); err == nil {
+			if body, ok := objects[dir][id]; ok {
+				fmt.Fprint(w, body)
+				return
+			}
+
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail":"Not found."}`)
+			return
+		}
+
+		items, ok := objects[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		ids := make([]int64, 0, len(items))
+		for id := range items {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		results := ""
+		if offset < len(ids) {
+			results = items[ids[offset]]
+		}
+
+		fmt.Fprintf(w, `{"count":%d,"results":[%s]}`, len(ids), results)
+	}))
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client.New(runtimeclient.New(u.Host, "/api", []string{u.Scheme}), strfmt.Default)
+}
+
+func TestExport(t *testing.T) {
+	c := exportTestClient(t, map[string]map[int64]string{
+		"/api/extras/tags/": {
+			1: `{"id":1,"name":"Production","slug":"production","color":"ff0000"}`,
+		},
+		"/api/tenancy/tenants/": {
+			2: `{"id":2,"name":"Acme","slug":"acme",
+				"tags":[{"id":1,"name":"Production","slug":"production","color":"ff0000"}],
+				"custom_fields":{"cust_id":null}}`,
+		},
+		"/api/dcim/sites/": {
+			3: `{"id":3,"name":"DC 1","slug":"dc1","status":{"value":"active","label":"Active"},"tenant":{"id":2}}`,
+			4: `{"id":4,"name":"DC 2","slug":"dc2","status":{"value":"planned","label":"Planned"},"tenant":{"id":99}}`,
+		},
+		"/api/dcim/platforms/": {
+			8: `{"id":8,"name":"Junos","slug":"junos","manufacturer":null,"napalm_driver":"junos","napalm_args":{"timeout":60},"description":""}`,
+		},
+		"/api/ipam/vrfs/": {
+			5: `{"id":5,"name":"Blue","enforce_unique":true}`,
+		},
+		"/api/ipam/prefixes/": {
+			6: `{"id":6,"prefix":"10.0.0.0/24","family":{"value":4,"label":"IPv4"},"vrf":{"id":5},"site":{"id":3},"status":{"value":"active","label":"Active"}}`,
+			7: `{"id":7,"prefix":"10.1.0.0/24","family":{"value":4,"label":"IPv4"},"status":{"value":"active","label":"Active"}}`,
+		},
+	})
+
+	dir := t.TempDir()
+	types := []string{"netbox_ipam_prefix", "netbox_tenancy_tenant", "netbox_extras_tag", "netbox_dcim_site", "netbox_ipam_vrf", "netbox_dcim_platform"}
+
+	if err := Export(context.Background(), c, dir, types); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"netbox_tenancy_tenant.tf": `import {
+  to = netbox_tenancy_tenant.acme
+  id = "2"
+}
+
+resource "netbox_tenancy_tenant" "acme" {
+  name = "Acme"
+  slug = "acme"
+  tags {
+    name = "Production"
+    slug = "production"
+  }
+}
+`,
+		"netbox_dcim_site.tf": `import {
+  to = netbox_dcim_site.dc1
+  id = "3"
+}
+
+resource "netbox_dcim_site" "dc1" {
+  name      = "DC 1"
+  slug      = "dc1"
+  tenant_id = netbox_tenancy_tenant.acme.id
+}
+
+import {
+  to = netbox_dcim_site.dc2
+  id = "4"
+}
+
+resource "netbox_dcim_site" "dc2" {
+  name      = "DC 2"
+  slug      = "dc2"
+  status    = "planned"
+  tenant_id = 99
+}
+`,
+		"netbox_dcim_platform.tf": `import {
+  to = netbox_dcim_platform.junos
+  id = "8"
+}
+
+resource "netbox_dcim_platform" "junos" {
+  name          = "Junos"
+  napalm_args   = "{\"timeout\":60}"
+  napalm_driver = "junos"
+  slug          = "junos"
+}
+`,
+		"netbox_ipam_prefix.tf": `import {
+  to = netbox_ipam_prefix.blue_10_0_0_0_24
+  id = "6"
+}
+
+resource "netbox_ipam_prefix" "blue_10_0_0_0_24" {
+  prefix  = "10.0.0.0/24"
+  site_id = netbox_dcim_site.dc1.id
+  status  = "active"
+  vrf_id  = netbox_ipam_vrf.blue.id
+}
+
+import {
+  to = netbox_ipam_prefix.prefix_10_1_0_0_24
+  id = "7"
+}
+
+resource "netbox_ipam_prefix" "prefix_10_1_0_0_24" {
+  prefix = "10.1.0.0/24"
+  status = "active"
+}
+`,
+	}
+
+	for name, want := range expected {
+		got, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != want {
+			t.Errorf("unexpected %s:\n%s\nexpected:\n%s", name, got, want)
+		}
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != len(types) {
+		t.Errorf("expected %d files, got %d", len(types), len(files))
+	}
+}
+
+func TestExport_unsupportedType(t *testing.T) {
+	err := Export(context.Background(), nil, t.TempDir(), []string{"netbox_dcim_site", "netbox_unknown"})
+	if err == nil || !strings.Contains(err.Error(), "netbox_unknown") {
+		t.Fatalf("expected an unsupported type error, got %v", err)
+	}
+}
+
+func TestListIpamRouteTargets_netbox29(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail":"Not found."}`)
+	})
+
+	ids, count, err := listIpamRouteTargets(context.Background(), c, exportPageSize, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(ids) != 0 || count != 0 {
+		t.Fatalf("expected no route targets, got %v of %d", ids, count)
+	}
+}
@@ -0,0 +1,1099 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/circuits"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+	"github.com/netbox-community/go-netbox/netbox/client/extras"
+	"github.com/netbox-community/go-netbox/netbox/client/ipam"
+	"github.com/netbox-community/go-netbox/netbox/client/tenancy"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// exportTypes lists the resource types supported by Export. Types are read
+// in this order, so a type whose label includes a reference must come after
+// the referenced type.
+var exportTypes = []*exportType{
+	{
+		name:     "netbox_extras_tag",
+		resource: resourceExtrasTag,
+		list:     listExtrasTags,
+		label:    []string{"slug"},
+	},
+	{
+		name:     "netbox_extras_custom_field",
+		resource: resourceExtrasCustomField,
+		list:     listExtrasCustomFields,
+		label:    []string{"name"},
+	},
+	{
+		name:     "netbox_tenancy_tenant_group",
+		resource: resourceTenancyTenantGroup,
+		list:     listTenancyTenantGroups,
+		label:    []string{"slug"},
+		references: staticReferences(map[string]string{
+			"parent_id": "netbox_tenancy_tenant_group",
+		}),
+	},
+	{
+		name:     "netbox_tenancy_tenant",
+		resource: resourceTenancyTenant,
+		list:     listTenancyTenants,
+		label:    []string{"slug"},
+		references: staticReferences(map[string]string{
+			"group_id": "netbox_tenancy_tenant_group",
+		}),
+	},
+	{
+		name:     "netbox_dcim_region",
+		resource: resourceDcimRegion,
+		list:     listDcimRegions,
+		label:    []string{"slug"},
+		references: staticReferences(map[string]string{
+			"parent_id": "netbox_dcim_region",
+		}),
+	},
+	{
+		name:     "netbox_dcim_site",
+		resource: resourceDcimSite,
+		list:     listDcimSites,
+		label:    []string{"slug"},
+		references: staticReferences(map[string]string{
+			"region_id": "netbox_dcim_region",
+			"tenant_id": "netbox_tenancy_tenant",
+		}),
+	},
+	{
+		name:     "netbox_dcim_rack_group",
+		resource: resourceDcimRackGroup,
+		list:     listDcimRackGroups,
+		label:    []string{"slug"},
+		references: staticReferences(map[string]string{
+			"site_id":   "netbox_dcim_site",
+			"parent_id": "netbox_dcim_rack_group",
+		}),
+	},
+	{
+		name:     "netbox_dcim_rack_role",
+		resource: resourceDcimRackRole,
+		list:     listDcimRackRoles,
+		label:    []string{"slug"},
+	},
+	{
+		name:     "netbox_dcim_rack",
+		resource: resourceDcimRack,
+		list:     listDcimRacks,
+		label:    []string{"site_id", "name"},
+		references: staticReferences(map[string]string{
+			"site_id":   "netbox_dcim_site",
+			"group_id":  "netbox_dcim_rack_group",
+			"tenant_id": "netbox_tenancy_tenant",
+			"role_id":   "netbox_dcim_rack_role",
+		}),
+	},
+	{
+		name:     "netbox_dcim_rack_reservation",
+		resource: resourceDcimRackReservation,
+		list:     listDcimRackReservations,
+		label:    []string{"rack_id", "description"},
+		references: staticReferences(map[string]string{
+			"rack_id":   "netbox_dcim_rack",
+			"tenant_id": "netbox_tenancy_tenant",
+		}),
+	},
+	{
+		name:     "netbox_dcim_power_panel",
+		resource: resourceDcimPowerPanel,
+		list:     listDcimPowerPanels,
+		label:    []string{"site_id", "name"},
+		references: staticReferences(map[string]string{
+			"site_id":       "netbox_dcim_site",
+			"rack_group_id": "netbox_dcim_rack_group",
+		}),
+	},
+	{
+		name:     "netbox_dcim_power_feed",
+		resource: resourceDcimPowerFeed,
+		list:     listDcimPowerFeeds,
+		label:    []string{"power_panel_id", "name"},
+		references: staticReferences(map[string]string{
+			"power_panel_id": "netbox_dcim_power_panel",
+			"rack_id":        "netbox_dcim_rack",
+		}),
+	},
+	{
+		name:     "netbox_dcim_manufacturer",
+		resource: resourceDcimManufacturer,
+		list:     listDcimManufacturers,
+		label:    []string{"slug"},
+	},
+	{
+		name:     "netbox_dcim_device_type",
+		resource: resourceDcimDeviceType,
+		list:     listDcimDeviceTypes,
+		label:    []string{"slug"},
+		references: staticReferences(map[string]string{
+			"manufacturer_id": "netbox_dcim_manufacturer",
+		}),
+	},
+	{
+		name:     "netbox_dcim_device_role",
+		resource: resourceDcimDeviceRole,
+		list:     listDcimDeviceRoles,
+		label:    []string{"slug"},
+	},
+	{
+		name:     "netbox_dcim_platform",
+		resource: resourceDcimPlatform,
+		list:     listByRequest("/dcim/platforms/"),
+		label:    []string{"slug"},
+		references: staticReferences(map[string]string{
+			"manufacturer_id": "netbox_dcim_manufacturer",
+		}),
+	},
+	{
+		name:     "netbox_virtualization_cluster_type",
+		resource: resourceVirtualizationClusterType,
+		list:     listVirtualizationClusterTypes,
+		label:    []string{"slug"},
+	},
+	{
+		name:     "netbox_virtualization_cluster_group",
+		resource: resourceVirtualizationClusterGroup,
+		list:     listVirtualizationClusterGroups,
+		label:    []string{"slug"},
+	},
+	{
+		name:     "netbox_virtualization_cluster",
+		resource: resourceVirtualizationCluster,
+		list:     listVirtualizationClusters,
+		label:    []string{"name"},
+		references: staticReferences(map[string]string{
+			"type_id":   "netbox_virtualization_cluster_type",
+			"group_id":  "netbox_virtualization_cluster_group",
+			"site_id":   "netbox_dcim_site",
+			"tenant_id": "netbox_tenancy_tenant",
+		}),
+	},
+	{
+		name:     "netbox_virtualization_virtual_machine",
+		resource: resourceVirtualizationVirtualMachine,
+		list:     listByRequest("/virtualization/virtual-machines/"),
+		label:    []string{"name"},
+		references: staticReferences(map[string]string{
+			"cluster_id":  "netbox_virtualization_cluster",
+			"role_id":     "netbox_dcim_device_role",
+			"tenant_id":   "netbox_tenancy_tenant",
+			"platform_id": "netbox_dcim_platform",
+		}),
+	},
+	{
+		name:     "netbox_virtualization_interface",
+		resource: resourceVirtualizationInterface,
+		list:     listVirtualizationInterfaces,
+		label:    []string{"virtual_machine_id", "name"},
+		references: staticReferences(map[string]string{
+			"virtual_machine_id": "netbox_virtualization_virtual_machine",
+			"untagged_vlan_id":   "netbox_ipam_vlan",
+			"tagged_vlan":        "netbox_ipam_vlan",
+		}),
+	},
+	{
+		name:     "netbox_dcim_device",
+		resource: resourceDcimDevices,
+		list:     listByRequest("/dcim/devices/"),
+		label:    []string{"name"},
+		references: staticReferences(map[string]string{
+			"site_id":        "netbox_dcim_site",
+			"rack_id":        "netbox_dcim_rack",
+			"tenant_id":      "netbox_tenancy_tenant",
+			"device_type_id": "netbox_dcim_device_type",
+			"device_role_id": "netbox_dcim_device_role",
+			"platform_id":    "netbox_dcim_platform",
+			"cluster_id":     "netbox_virtualization_cluster",
+		}),
+		omit: []string{"virtual_chassis_id", "vc_position_id", "vc_priority_id"},
+	},
+	{
+		name:     "netbox_dcim_virtual_chassis",
+		resource: resourceDcimVirtualChassis,
+		list:     listDcimVirtualChassis,
+		label:    []string{"name"},
+		references: staticReferences(map[string]string{
+			"master_id": "netbox_dcim_device",
+		}),
+	},
+	{
+		name:     "netbox_dcim_interface",
+		resource: resourceDcimInterface,
+		list:     listByRequest("/dcim/interfaces/"),
+		label:    []string{"device_id", "name"},
+		references: staticReferences(map[string]string{
+			"device_id":        "netbox_dcim_device",
+			"untagged_vlan_id": "netbox_ipam_vlan",
+			"tagged_vlan":      "netbox_ipam_vlan",
+		}),
+	},
+	{
+		name:     "netbox_dcim_console_port",
+		resource: resourceDcimConsolePort,
+		list:     listByRequest("/dcim/console-ports/"),
+		label:    []string{"device_id", "name"},
+		references: staticReferences(map[string]string{
+			"device_id": "netbox_dcim_device",
+		}),
+	},
+	{
+		name:     "netbox_dcim_console_server_port",
+		resource: resourceDcimConsoleServerPort,
+		list:     listByRequest("/dcim/console-server-ports/"),
+		label:    []string{"device_id", "name"},
+		references: staticReferences(map[string]string{
+			"device_id": "netbox_dcim_device",
+		}),
+	},
+	{
+		name:     "netbox_dcim_power_port",
+		resource: resourceDcimPowerPort,
+		list:     listByRequest("/dcim/power-ports/"),
+		label:    []string{"device_id", "name"},
+		references: staticReferences(map[string]string{
+			"device_id": "netbox_dcim_device",
+		}),
+	},
+	{
+		name:     "netbox_dcim_power_outlet",
+		resource: resourceDcimPowerOutlet,
+		list:     listByRequest("/dcim/power-outlets/"),
+		label:    []string{"device_id", "name"},
+		references: staticReferences(map[string]string{
+			"device_id":     "netbox_dcim_device",
+			"power_port_id": "netbox_dcim_power_port",
+		}),
+	},
+	{
+		name:     "netbox_dcim_rear_port",
+		resource: resourceDcimRearPort,
+		list:     listByRequest("/dcim/rear-ports/"),
+		label:    []string{"device_id", "name"},
+		references: staticReferences(map[string]string{
+			"device_id": "netbox_dcim_device",
+		}),
+	},
+	{
+		name:     "netbox_dcim_front_port",
+		resource: resourceDcimFrontPort,
+		list:     listByRequest("/dcim/front-ports/"),
+		label:    []string{"device_id", "name"},
+		references: staticReferences(map[string]string{
+			"device_id":    "netbox_dcim_device",
+			"rear_port_id": "netbox_dcim_rear_port",
+		}),
+	},
+	{
+		name:       "netbox_dcim_cable",
+		resource:   resourceDcimCable,
+		list:       listByRequest("/dcim/cables/"),
+		label:      []string{"label"},
+		references: resourceDcimCableExportReferences,
+	},
+	{
+		name:     "netbox_ipam_rir",
+		resource: resourceIpamRir,
+		list:     listIpamRirs,
+		label:    []string{"slug"},
+	},
+	{
+		name:     "netbox_ipam_role",
+		resource: resourceIpamRole,
+		list:     listIpamRoles,
+		label:    []string{"slug"},
+	},
+	{
+		name:     "netbox_ipam_aggregates",
+		resource: resourceIpamAggregate,
+		list:     listIpamAggregates,
+		label:    []string{"prefix"},
+		references: staticReferences(map[string]string{
+			"rir_id": "netbox_ipam_rir",
+		}),
+	},
+	{
+		name:     "netbox_ipam_route_target",
+		resource: resourceIpamRouteTarget,
+		list:     listIpamRouteTargets,
+		label:    []string{"name"},
+		references: staticReferences(map[string]string{
+			"tenant_id": "netbox_tenancy_tenant",
+		}),
+	},
+	{
+		name:     "netbox_ipam_vrf",
+		resource: resourceIpamVRF,
+		list:     listIpamVrfs,
+		label:    []string{"name"},
+		references: staticReferences(map[string]string{
+			"tenant_id":      "netbox_tenancy_tenant",
+			"import_targets": "netbox_ipam_route_target",
+			"export_targets": "netbox_ipam_route_target",
+		}),
+	},
+	{
+		name:     "netbox_ipam_vlan",
+		resource: resourceIpamVlan,
+		list:     listIpamVlans,
+		label:    []string{"site_id", "name"},
+		references: staticReferences(map[string]string{
+			"site_id":   "netbox_dcim_site",
+			"tenant_id": "netbox_tenancy_tenant",
+			"role_id":   "netbox_ipam_role",
+		}),
+	},
+	{
+		name:     "netbox_ipam_prefix",
+		resource: resourceIpamPrefix,
+		list:     listIpamPrefixes,
+		label:    []string{"vrf_id", "prefix"},
+		references: staticReferences(map[string]string{
+			"vrf_id":    "netbox_ipam_vrf",
+			"site_id":   "netbox_dcim_site",
+			"tenant_id": "netbox_tenancy_tenant",
+			"vlan_id":   "netbox_ipam_vlan",
+			"role_id":   "netbox_ipam_role",
+		}),
+	},
+	{
+		name:       "netbox_ipam_ipaddress",
+		resource:   resourceIpamIPAddress,
+		list:       listIpamIPAddresses,
+		label:      []string{"vrf_id", "address"},
+		references: resourceIpamIPAddressExportReferences,
+	},
+	{
+		name:     "netbox_ipam_ip_range",
+		resource: resourceIpamIPRange,
+		list:     listIpamIPRanges,
+		label:    []string{"vrf_id", "start_address"},
+		references: staticReferences(map[string]string{
+			"vrf_id":    "netbox_ipam_vrf",
+			"tenant_id": "netbox_tenancy_tenant",
+			"role_id":   "netbox_ipam_role",
+		}),
+	},
+	{
+		name:     "netbox_ipam_service",
+		resource: resourceIpamService,
+		list:     listByRequest("/ipam/services/"),
+		label:    []string{"device_id", "virtual_machine_id", "name"},
+		references: staticReferences(map[string]string{
+			"device_id":          "netbox_dcim_device",
+			"virtual_machine_id": "netbox_virtualization_virtual_machine",
+			"ipaddresses":        "netbox_ipam_ipaddress",
+		}),
+	},
+	{
+		name:     "netbox_circuits_provider",
+		resource: resourceCircuitsProvider,
+		list:     listCircuitsProviders,
+		label:    []string{"slug"},
+	},
+	{
+		name:     "netbox_circuits_circuit",
+		resource: resourceCircuitsCircuit,
+		list:     listCircuitsCircuits,
+		label:    []string{"cid"},
+		references: staticReferences(map[string]string{
+			"provider_id": "netbox_circuits_provider",
+			"tenant_id":   "netbox_tenancy_tenant",
+		}),
+	},
+	{
+		name:     "netbox_extras_config_context",
+		resource: resourceExtrasConfigContext,
+		list:     listByRequest("/extras/config-contexts/"),
+		label:    []string{"name"},
+		references: staticReferences(map[string]string{
+			"regions":        "netbox_dcim_region",
+			"sites":          "netbox_dcim_site",
+			"roles":          "netbox_dcim_device_role",
+			"platforms":      "netbox_dcim_platform",
+			"cluster_groups": "netbox_virtualization_cluster_group",
+			"clusters":       "netbox_virtualization_cluster",
+			"tenant_groups":  "netbox_tenancy_tenant_group",
+			"tenants":        "netbox_tenancy_tenant",
+		}),
+	},
+}
+
+func staticReferences(references map[string]string) func(d *schema.ResourceData) map[string]string {
+	return func(d *schema.ResourceData) map[string]string {
+		return references
+	}
+}
+
+// exportContentTypes maps the NetBox content types used by polymorphic
+// object references to the resource types that manage them.
+var exportContentTypes = map[string]string{
+	"dcim.consoleport":           "netbox_dcim_console_port",
+	"dcim.consoleserverport":     "netbox_dcim_console_server_port",
+	"dcim.frontport":             "netbox_dcim_front_port",
+	"dcim.interface":             "netbox_dcim_interface",
+	"dcim.powerfeed":             "netbox_dcim_power_feed",
+	"dcim.poweroutlet":           "netbox_dcim_power_outlet",
+	"dcim.powerport":             "netbox_dcim_power_port",
+	"dcim.rearport":              "netbox_dcim_rear_port",
+	"virtualization.vminterface": "netbox_virtualization_interface",
+}
+
+// resourceIpamIPAddressExportReferences references the assigned object
+// according to its type, which is either a device or a VM interface.
+func resourceIpamIPAddressExportReferences(d *schema.ResourceData) map[string]string {
+	references := map[string]string{
+		"vrf_id":         "netbox_ipam_vrf",
+		"tenant_id":      "netbox_tenancy_tenant",
+		"nat_outside_id": "netbox_ipam_ipaddress",
+	}
+
+	if v, ok := exportContentTypes[d.Get("assigned_object_type").(string)]; ok {
+		references["assigned_object_id"] = v
+	}
+
+	return references
+}
+
+func resourceDcimCableExportReferences(d *schema.ResourceData) map[string]string {
+	references := map[string]string{}
+
+	if v, ok := exportContentTypes[d.Get("termination_a_type").(string)]; ok {
+		references["termination_a_id"] = v
+	}
+
+	if v, ok := exportContentTypes[d.Get("termination_b_type").(string)]; ok {
+		references["termination_b_id"] = v
+	}
+
+	return references
+}
+
+// listByRequest returns an exportListFunc listing the objects at path through
+// netboxRequest, for types go-netbox can't decode.
+func listByRequest(path string) exportListFunc {
+	return func(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+		var result struct {
+			Count   int64 `json:"count"`
+			Results []struct {
+				ID int64 `json:"id"`
+			} `json:"results"`
+		}
+
+		query := fmt.Sprintf("?limit=%d&offset=%d", limit, offset)
+		if err := netboxRequest(ctx, c, "GET", path+query, nil, &result); err != nil {
+			return nil, 0, err
+		}
+
+		ids := make([]int64, 0, len(result.Results))
+		for _, v := range result.Results {
+			ids = append(ids, v.ID)
+		}
+
+		return ids, result.Count, nil
+	}
+}
+
+func listExtrasTags(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &extras.ExtrasTagsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Extras.ExtrasTagsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listTenancyTenants(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &tenancy.TenancyTenantsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Tenancy.TenancyTenantsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listTenancyTenantGroups(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &tenancy.TenancyTenantGroupsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Tenancy.TenancyTenantGroupsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimRegions(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimRegionsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimRegionsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimSites(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimSitesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimSitesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimRackGroups(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimRackGroupsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimRackGroupsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimRackRoles(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimRackRolesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimRackRolesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimRacks(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimRacksListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimRacksList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimRackReservations(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimRackReservationsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimRackReservationsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimPowerPanels(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimPowerPanelsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimPowerPanelsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimPowerFeeds(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimPowerFeedsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimPowerFeedsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimManufacturers(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimManufacturersListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimManufacturersList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimDeviceTypes(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimDeviceTypesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimDeviceTypesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimDeviceRoles(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimDeviceRolesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimDeviceRolesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listDcimVirtualChassis(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &dcim.DcimVirtualChassisListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Dcim.DcimVirtualChassisList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listIpamRirs(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &ipam.IpamRirsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Ipam.IpamRirsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listIpamRoles(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &ipam.IpamRolesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Ipam.IpamRolesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listIpamAggregates(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &ipam.IpamAggregatesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Ipam.IpamAggregatesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listIpamVrfs(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &ipam.IpamVrfsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Ipam.IpamVrfsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listIpamVlans(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &ipam.IpamVlansListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Ipam.IpamVlansList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listIpamPrefixes(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &ipam.IpamPrefixesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Ipam.IpamPrefixesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listIpamIPAddresses(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &ipam.IpamIPAddressesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Ipam.IpamIPAddressesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listCircuitsProviders(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &circuits.CircuitsProvidersListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Circuits.CircuitsProvidersList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listCircuitsCircuits(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &circuits.CircuitsCircuitsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Circuits.CircuitsCircuitsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listVirtualizationClusterTypes(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &virtualization.VirtualizationClusterTypesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Virtualization.VirtualizationClusterTypesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listVirtualizationClusterGroups(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &virtualization.VirtualizationClusterGroupsListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Virtualization.VirtualizationClusterGroupsList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listVirtualizationClusters(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &virtualization.VirtualizationClustersListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Virtualization.VirtualizationClustersList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+func listVirtualizationInterfaces(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	params := &virtualization.VirtualizationInterfacesListParams{
+		Context: ctx,
+		Limit:   &limit,
+		Offset:  &offset,
+	}
+
+	resp, err := c.Virtualization.VirtualizationInterfacesList(params, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return ids, *resp.Payload.Count, nil
+}
+
+// listIpamRouteTargets lists route targets, treating NetBox versions older
+// than 2.10, which have no route targets endpoint, as having none.
+func listIpamRouteTargets(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	ids, count, err := listByRequest("/ipam/route-targets/")(ctx, c, limit, offset)
+	if isNotFound(err) {
+		return nil, 0, nil
+	}
+
+	return ids, count, err
+}
+
+// listIpamIPRanges lists IP ranges, treating NetBox versions older than 3.0,
+// which have no IP ranges endpoint, as having none.
+func listIpamIPRanges(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	ids, count, err := listByRequest("/ipam/ip-ranges/")(ctx, c, limit, offset)
+	if isNotFound(err) {
+		return nil, 0, nil
+	}
+
+	return ids, count, err
+}
+
+// listExtrasCustomFields lists custom fields, treating NetBox versions older
+// than 2.10, which have no custom fields endpoint, as having none.
+func listExtrasCustomFields(ctx context.Context, c *client.NetBoxAPI, limit, offset int64) ([]int64, int64, error) {
+	ids, count, err := listByRequest("/extras/custom-fields/")(ctx, c, limit, offset)
+	if isNotFound(err) {
+		return nil, 0, nil
+	}
+
+	return ids, count, err
+}
@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/ipam"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// naturalKeyResolver looks up the ID of the object identified by key.
+type naturalKeyResolver func(ctx context.Context, c *client.NetBoxAPI, key string) (int64, error)
+
+// importByNaturalKey returns an importer accepting either a numeric ID or a
+// natural key, which is resolved to the object's ID before it is read.
+func importByNaturalKey(resolve naturalKeyResolver) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		if _, err := strconv.ParseInt(d.Id(), 10, 64); err == nil {
+			return []*schema.ResourceData{d}, nil
+		}
+
+		id, err := resolve(ctx, m.(*client.NetBoxAPI), d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(strconv.FormatInt(id, 10))
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// singleID returns the only ID in ids, failing when the key matched no object
+// or more than one.
+func singleID(kind, key string, ids []int64) (int64, error) {
+	switch len(ids) {
+	case 0:
+		return 0, fmt.Errorf("No %s found matching %q", kind, key)
+	case 1:
+		return ids[0], nil
+	default:
+		return 0, fmt.Errorf("Found %d %s objects matching %q, import by ID instead", len(ids), kind, key)
+	}
+}
+
+// splitImportKey splits a key of the form "parent/child" at the first slash.
+func splitImportKey(key, format string) (string, string, error) {
+	parts := strings.SplitN(key, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected import ID %q, expected a numeric ID or %s", key, format)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// resolveDeviceComponent returns a resolver for device components, such as
+// interfaces and console ports, listed at path and imported as "device/name".
+// The key is split at the first slash since component names such as
+// GigabitEthernet0/1 commonly contain slashes. Components are listed through
+// netboxRequest since go-netbox can't decode those that are cabled.
+func resolveDeviceComponent(path, kind string) naturalKeyResolver {
+	return func(ctx context.Context, c *client.NetBoxAPI, key string) (int64, error) {
+		device, name, err := splitImportKey(key, "device/"+strings.ReplaceAll(kind, " ", "-"))
+		if err != nil {
+			return 0, err
+		}
+
+		var result struct {
+			Results []struct {
+				ID int64 `json:"id"`
+			} `json:"results"`
+		}
+
+		query := url.Values{"device": {device}, "name": {name}}
+
+		err = netboxRequest(ctx, c, "GET", path+"?"+query.Encode(), nil, &result)
+		if err != nil {
+			return 0, fmt.Errorf("Unable to list %ss: %s", kind, errorDetail(err))
+		}
+
+		ids := make([]int64, 0, len(result.Results))
+		for _, v := range result.Results {
+			ids = append(ids, v.ID)
+		}
+
+		return singleID(kind, key, ids)
+	}
+}
+
+// splitVRFImportKey splits a key of the form "vrf-name/cidr". The CIDR is taken
+// from the end of the key so that VRF names may contain slashes, and an empty
+// VRF name selects the global table.
+func splitVRFImportKey(key string) (string, string, error) {
+	mask := strings.LastIndex(key, "/")
+	if mask > 0 {
+		if sep := strings.LastIndex(key[:mask], "/"); sep >= 0 {
+			return key[:sep], key[sep+1:], nil
+		}
+	}
+
+	return "", "", fmt.Errorf("Unexpected import ID %q, expected a numeric ID or vrf-name/cidr", key)
+}
+
+// resolveVRFFilter returns the vrf_id filter value selecting the VRF with the
+// given name, or the global table when name is empty.
+func resolveVRFFilter(ctx context.Context, c *client.NetBoxAPI, name string) (string, error) {
+	if name == "" {
+		return "null", nil
+	}
+
+	params := &ipam.IpamVrfsListParams{
+		Context: ctx,
+		Name:    &name,
+	}
+
+	resp, err := c.Ipam.IpamVrfsList(params, nil)
+	if err != nil {
+		return "", fmt.Errorf("Unable to list VRFs: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	id, err := singleID("VRF", name, ids)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatInt(id, 10), nil
+}
@@ -0,0 +1,130 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	runtimeclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+// importTestClient returns a client for a fake NetBox answering list requests
+// with the IDs registered for their path and query string.
+func importTestClient(t *testing.T, results map[string][]int64) *client.NetBoxAPI {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids, ok := results[r.URL.Path+"?"+r.URL.Query().Encode()]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		body := ""
+		for i, id := range ids {
+			if i > 0 {
+				body += ","
+			}
+			body += fmt.Sprintf(`{"id":%d}`, id)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"count":%d,"results":[%s]}`, len(ids), body)
+	}))
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client.New(runtimeclient.New(u.Host, "/api", []string{u.Scheme}), strfmt.Default)
+}
+
+func TestImportByNaturalKey(t *testing.T) {
+	c := importTestClient(t, map[string][]int64{
+		"/api/dcim/sites/?slug=dc1":                                                  {3},
+		"/api/dcim/sites/?slug=missing":                                              {},
+		"/api/tenancy/tenants/?slug=acme":                                            {4},
+		"/api/tenancy/tenant-groups/?slug=customers":                                 {18},
+		"/api/dcim/devices/?name=sw1&site=dc1":                                       {7},
+		"/api/dcim/devices/?name=sw2&site=dc1":                                       {8, 9},
+		"/api/dcim/interfaces/?device=sw1&name=GigabitEthernet0%2F1":                 {11},
+		"/api/dcim/console-ports/?device=sw1&name=con0":                              {12},
+		"/api/dcim/front-ports/?device=pp1&name=1":                                   {13, 14},
+		"/api/dcim/rack-groups/?slug=storage":                                        {5, 6},
+		"/api/virtualization/clusters/?name=esx+prod":                                {15},
+		"/api/virtualization/virtual-machines/?cluster=esx&name=vm01":                {16},
+		"/api/virtualization/interfaces/?cluster=esx&name=eth0&virtual_machine=vm01": {17},
+		"/api/ipam/roles/?slug=production":                                           {19},
+		"/api/ipam/route-targets/?name=65000%3A100":                                  {20},
+		"/api/ipam/vrfs/?name=blue%2Fprod":                                           {2},
+		"/api/extras/custom-fields/?name=cost_center":                                {23},
+		"/api/extras/config-contexts/?name=ntp+servers":                              {24},
+		"/api/ipam/prefixes/?prefix=10.0.0.0%2F24&vrf_id=2":                          {21},
+		"/api/ipam/prefixes/?prefix=10.0.0.0%2F24&vrf_id=null":                       {22},
+		"/api/ipam/ip-addresses/?address=10.0.0.1%2F24&vrf_id=2":                     {31},
+	})
+
+	cases := map[string]struct {
+		resource *schema.Resource
+		key      string
+		id       string
+		err      bool
+	}{
+		"numeric id":           {resourceDcimSite(), "42", "42", false},
+		"site slug":            {resourceDcimSite(), "dc1", "3", false},
+		"site not found":       {resourceDcimSite(), "missing", "", true},
+		"tenant slug":          {resourceTenancyTenant(), "acme", "4", false},
+		"tenant group slug":    {resourceTenancyTenantGroup(), "customers", "18", false},
+		"device":               {resourceDcimDevices(), "dc1/sw1", "7", false},
+		"device ambiguous":     {resourceDcimDevices(), "dc1/sw2", "", true},
+		"device malformed":     {resourceDcimDevices(), "sw1", "", true},
+		"interface":            {resourceDcimInterface(), "sw1/GigabitEthernet0/1", "11", false},
+		"console port":         {resourceDcimConsolePort(), "sw1/con0", "12", false},
+		"front port ambiguous": {resourceDcimFrontPort(), "pp1/1", "", true},
+		"rack group ambiguous": {resourceDcimRackGroup(), "storage", "", true},
+		"cluster name":         {resourceVirtualizationCluster(), "esx prod", "15", false},
+		"virtual machine":      {resourceVirtualizationVirtualMachine(), "esx/vm01", "16", false},
+		"vm interface":         {resourceVirtualizationInterface(), "esx/vm01/eth0", "17", false},
+		"ipam role slug":       {resourceIpamRole(), "production", "19", false},
+		"route target name":    {resourceIpamRouteTarget(), "65000:100", "20", false},
+		"custom field name":    {resourceExtrasCustomField(), "cost_center", "23", false},
+		"config context name":  {resourceExtrasConfigContext(), "ntp servers", "24", false},
+		"prefix in vrf":        {resourceIpamPrefix(), "blue/prod/10.0.0.0/24", "21", false},
+		"prefix in global":     {resourceIpamPrefix(), "/10.0.0.0/24", "22", false},
+		"prefix malformed":     {resourceIpamPrefix(), "10.0.0.0", "", true},
+		"ip address in vrf":    {resourceIpamIPAddress(), "blue/prod/10.0.0.1/24", "31", false},
+		"ip address malformed": {resourceIpamIPAddress(), "blue", "", true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, tc.resource.Schema, nil)
+			d.SetId(tc.key)
+
+			result, err := tc.resource.Importer.StateContext(context.Background(), d, c)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected an error, got ID %q", d.Id())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(result) != 1 || result[0].Id() != tc.id {
+				t.Fatalf("expected ID %q, got %q", tc.id, d.Id())
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package netbox
+
+import (
+	"encoding/json"
+)
+
+// normalizeJSON returns the compact form of a JSON document with object keys
+// sorted, so that documents differing only in formatting compare equal. An
+// empty string or a JSON null normalizes to an empty string.
+func normalizeJSON(v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+
+	var data interface{}
+	if err := json.Unmarshal([]byte(v), &data); err != nil {
+		return "", err
+	}
+
+	if data == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// jsonStateFunc stores JSON attributes in their normalized form. Invalid
+// documents are stored as is and rejected by isJSON.
+func jsonStateFunc(v interface{}) string {
+	s, err := normalizeJSON(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+
+	return s
+}
@@ -0,0 +1,31 @@
+package netbox
+
+import (
+	"testing"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	cases := map[string]string{
+		``:                      ``,
+		`null`:                  ``,
+		`{"b": 1, "a": [1, 2]}`: `{"a":[1,2],"b":1}`,
+		"{\n  \"a\": {\"d\": true, \"c\": null}\n}": `{"a":{"c":null,"d":true}}`,
+		`"text"`: `"text"`,
+	}
+
+	for input, want := range cases {
+		got, err := normalizeJSON(input)
+		if err != nil {
+			t.Errorf("normalizeJSON(%q): unexpected error: %s", input, err)
+			continue
+		}
+
+		if got != want {
+			t.Errorf("normalizeJSON(%q) = %q, expected %q", input, got, want)
+		}
+	}
+
+	if _, err := normalizeJSON(`{"a":`); err == nil {
+		t.Errorf("expected an error for invalid JSON")
+	}
+}
@@ -0,0 +1,87 @@
+package netbox
+
+import (
+	"io"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// limitedTransport throttles every HTTP request sent to NetBox. It sits below
+// retryTransport, so each retry waits for the limits like any other request.
+// A single instance is shared by all resources and data sources, so the
+// limits apply to the provider as a whole.
+type limitedTransport struct {
+	transport http.RoundTripper
+	slots     chan struct{}
+	limiter   *rate.Limiter
+}
+
+// newLimitedTransport wraps transport so that at most maxConcurrent requests
+// are in flight and at most requestsPerSecond requests are started each
+// second. A value of zero disables the corresponding limit.
+func newLimitedTransport(transport http.RoundTripper, maxConcurrent int, requestsPerSecond float64) http.RoundTripper {
+	if maxConcurrent <= 0 && requestsPerSecond <= 0 {
+		return transport
+	}
+
+	t := &limitedTransport{
+		transport: transport,
+	}
+
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+
+	if requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), 1)
+	}
+
+	return t
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	release := func() {}
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+			var once sync.Once
+			release = func() { once.Do(func() { <-t.slots }) }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+
+	// The request is in flight until its response has been read.
+	resp.Body = &limitedBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+type limitedBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *limitedBody) Close() error {
+	defer b.release()
+
+	return b.ReadCloser.Close()
+}
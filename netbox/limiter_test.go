@@ -0,0 +1,166 @@
+package netbox
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type limiterTestTransport struct {
+	inFlight    int32
+	maxInFlight int32
+	calls       int32
+	delay       time.Duration
+	status      func(call int32) int
+}
+
+func (t *limiterTestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	call := atomic.AddInt32(&t.calls, 1)
+
+	n := atomic.AddInt32(&t.inFlight, 1)
+	defer atomic.AddInt32(&t.inFlight, -1)
+
+	for {
+		max := atomic.LoadInt32(&t.maxInFlight)
+		if n <= max || atomic.CompareAndSwapInt32(&t.maxInFlight, max, n) {
+			break
+		}
+	}
+
+	time.Sleep(t.delay)
+
+	status := http.StatusOK
+	if t.status != nil {
+		status = t.status(call)
+	}
+
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}, nil
+}
+
+func limiterTestRequest(t *testing.T, ctx context.Context) *http.Request {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://netbox.invalid/api/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return req
+}
+
+func TestLimitedTransport_maxConcurrent(t *testing.T) {
+	inner := &limiterTestTransport{delay: 20 * time.Millisecond}
+	transport := newLimitedTransport(inner, 3, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 12; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := transport.RoundTrip(limiterTestRequest(t, context.Background()))
+			if err == nil {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if inner.calls != 12 {
+		t.Fatalf("expected 12 calls, got %d", inner.calls)
+	}
+
+	if inner.maxInFlight > 3 {
+		t.Fatalf("expected at most 3 concurrent calls, got %d", inner.maxInFlight)
+	}
+}
+
+func TestLimitedTransport_requestsPerSecond(t *testing.T) {
+	inner := &limiterTestTransport{}
+	transport := newLimitedTransport(inner, 0, 20)
+
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		resp, err := transport.RoundTrip(limiterTestRequest(t, context.Background()))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	// The first request starts immediately, the remaining four wait 50ms each.
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Fatalf("expected 5 requests at 20/s to take at least 200ms, took %s", elapsed)
+	}
+}
+
+func TestLimitedTransport_retries(t *testing.T) {
+	inner := &limiterTestTransport{
+		status: func(call int32) int {
+			if call < 5 {
+				return http.StatusServiceUnavailable
+			}
+
+			return http.StatusOK
+		},
+	}
+	transport := newRetryTransport(newLimitedTransport(inner, 0, 20), 4, time.Millisecond, 5*time.Millisecond)
+
+	start := time.Now()
+
+	resp, err := transport.RoundTrip(limiterTestRequest(t, context.Background()))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	if inner.calls != 5 {
+		t.Fatalf("expected 5 attempts, got %d", inner.calls)
+	}
+
+	// Each retry is throttled like a new request.
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Fatalf("expected 5 attempts at 20/s to take at least 200ms, took %s", elapsed)
+	}
+}
+
+func TestLimitedTransport_contextCanceled(t *testing.T) {
+	inner := &limiterTestTransport{delay: 200 * time.Millisecond}
+	transport := newLimitedTransport(inner, 1, 0)
+
+	go func() {
+		resp, err := transport.RoundTrip(limiterTestRequest(t, context.Background()))
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := transport.RoundTrip(limiterTestRequest(t, ctx)); err != context.DeadlineExceeded {
+		t.Fatalf("expected %s, got %v", context.DeadlineExceeded, err)
+	}
+
+	if calls := atomic.LoadInt32(&inner.calls); calls != 1 {
+		t.Fatalf("expected the canceled request not to be sent, got %d calls", calls)
+	}
+}
+
+func TestLimitedTransport_disabled(t *testing.T) {
+	inner := &limiterTestTransport{}
+
+	if transport := newLimitedTransport(inner, 0, 0); transport != inner {
+		t.Fatal("expected the transport to be returned unchanged when no limits are set")
+	}
+}
@@ -3,7 +3,9 @@ package netbox
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
@@ -28,6 +30,90 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("NETBOX_TOKEN", nil),
 			},
+
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NETBOX_INSECURE_SKIP_VERIFY", false),
+			},
+
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CA_CERT_FILE", nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+			},
+
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CA_CERT_PEM", nil),
+				ConflictsWith: []string{"ca_cert_file"},
+			},
+
+			"client_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_CERT_FILE", nil),
+				ConflictsWith: []string{"client_cert_pem"},
+			},
+
+			"client_key_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_KEY_FILE", nil),
+				ConflictsWith: []string{"client_key_pem"},
+			},
+
+			"client_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_CERT_PEM", nil),
+				ConflictsWith: []string{"client_cert_file"},
+			},
+
+			"client_key_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				DefaultFunc:   schema.EnvDefaultFunc("NETBOX_CLIENT_KEY_PEM", nil),
+				ConflictsWith: []string{"client_key_file"},
+			},
+
+			"max_retries": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_MAX_RETRIES", 4),
+				ValidateDiagFunc: intAtLeast(0),
+			},
+
+			"retry_wait_min": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_RETRY_WAIT_MIN", 1),
+				ValidateDiagFunc: intAtLeast(0),
+			},
+
+			"retry_wait_max": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_RETRY_WAIT_MAX", 30),
+				ValidateDiagFunc: intAtLeast(0),
+			},
+
+			"max_concurrent_requests": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateDiagFunc: intAtLeast(0),
+			},
+
+			"requests_per_second": {
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				DefaultFunc:      schema.EnvDefaultFunc("NETBOX_REQUESTS_PER_SECOND", 0),
+				ValidateDiagFunc: floatAtLeast(0),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -35,25 +121,56 @@ func Provider() *schema.Provider {
 			"netbox_ipam_available_prefixes": dataSourceIpamAvailablePrefixes(),
 			"netbox_ipam_prefix":             dataSourceIpamPrefix(),
 			"netbox_ipam_prefixes":           dataSourceIpamPrefixes(),
+			"netbox_ipam_role":               dataSourceIpamRole(),
+			"netbox_tenancy_tenant_groups":   dataSourceTenancyTenantGroups(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"netbox_ipam_aggregates":       resourceIpamAggregate(),
-			"netbox_ipam_available_prefix": resourceIpamAvailablePrefix(),
-			"netbox_ipam_prefix":           resourceIpamPrefix(),
-			"netbox_ipam_rir":              resourceIpamRir(),
-			"netbox_extras_tag":            resourceExtrasTag(),
-			"netbox_dcim_site":             resourceDcimSite(),
-			"netbox_dcim_rack":             resourceDcimRack(),
-			"netbox_dcim_device":           resourceDcimDevices(),
-			"netbox_circuits_circuit":      resourceCircuitsCircuit(),
-			"netbox_dcim_interface":        resourceDcimInterface(),
-			"netbox_dcim_region":           resourceDcimRegion(),
-			"netbox_ipam_vlan":             resourceIpamVlan(),
-			"netbox_ipam_ipaddress":        resourceIpamIPAddress(),
-			"netbox_tenancy_tenant":        resourceTenancyTenant(),
-			"netbox_ipam_vrf":              resourceIpamVRF(),
-			"netbox_circuits_provider":     resourceCircuitsProvider(),
+			"netbox_ipam_aggregates":                resourceIpamAggregate(),
+			"netbox_ipam_available_prefix":          resourceIpamAvailablePrefix(),
+			"netbox_ipam_prefix":                    resourceIpamPrefix(),
+			"netbox_ipam_rir":                       resourceIpamRir(),
+			"netbox_ipam_role":                      resourceIpamRole(),
+			"netbox_ipam_route_target":              resourceIpamRouteTarget(),
+			"netbox_ipam_service":                   resourceIpamService(),
+			"netbox_ipam_ip_range":                  resourceIpamIPRange(),
+			"netbox_extras_tag":                     resourceExtrasTag(),
+			"netbox_extras_custom_field":            resourceExtrasCustomField(),
+			"netbox_extras_config_context":          resourceExtrasConfigContext(),
+			"netbox_dcim_site":                      resourceDcimSite(),
+			"netbox_dcim_rack":                      resourceDcimRack(),
+			"netbox_dcim_rack_group":                resourceDcimRackGroup(),
+			"netbox_dcim_rack_role":                 resourceDcimRackRole(),
+			"netbox_dcim_rack_reservation":          resourceDcimRackReservation(),
+			"netbox_dcim_device":                    resourceDcimDevices(),
+			"netbox_circuits_circuit":               resourceCircuitsCircuit(),
+			"netbox_dcim_interface":                 resourceDcimInterface(),
+			"netbox_dcim_region":                    resourceDcimRegion(),
+			"netbox_ipam_vlan":                      resourceIpamVlan(),
+			"netbox_ipam_ipaddress":                 resourceIpamIPAddress(),
+			"netbox_tenancy_tenant":                 resourceTenancyTenant(),
+			"netbox_tenancy_tenant_group":           resourceTenancyTenantGroup(),
+			"netbox_ipam_vrf":                       resourceIpamVRF(),
+			"netbox_circuits_provider":              resourceCircuitsProvider(),
+			"netbox_dcim_manufacturer":              resourceDcimManufacturer(),
+			"netbox_dcim_device_type":               resourceDcimDeviceType(),
+			"netbox_dcim_device_role":               resourceDcimDeviceRole(),
+			"netbox_dcim_platform":                  resourceDcimPlatform(),
+			"netbox_dcim_cable":                     resourceDcimCable(),
+			"netbox_dcim_virtual_chassis":           resourceDcimVirtualChassis(),
+			"netbox_dcim_console_port":              resourceDcimConsolePort(),
+			"netbox_dcim_console_server_port":       resourceDcimConsoleServerPort(),
+			"netbox_dcim_power_port":                resourceDcimPowerPort(),
+			"netbox_dcim_power_outlet":              resourceDcimPowerOutlet(),
+			"netbox_dcim_power_panel":               resourceDcimPowerPanel(),
+			"netbox_dcim_power_feed":                resourceDcimPowerFeed(),
+			"netbox_dcim_rear_port":                 resourceDcimRearPort(),
+			"netbox_dcim_front_port":                resourceDcimFrontPort(),
+			"netbox_virtualization_cluster_type":    resourceVirtualizationClusterType(),
+			"netbox_virtualization_cluster_group":   resourceVirtualizationClusterGroup(),
+			"netbox_virtualization_cluster":         resourceVirtualizationCluster(),
+			"netbox_virtualization_virtual_machine": resourceVirtualizationVirtualMachine(),
+			"netbox_virtualization_interface":       resourceVirtualizationInterface(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
@@ -79,8 +196,25 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		u.Path = client.DefaultBasePath
 	}
 
+	tlsConfig, err := providerTLSConfig(d)
+	if err != nil {
+		return nil, diag.Errorf("Unable to configure TLS: %s", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	maxRetries := d.Get("max_retries").(int)
+	retryWaitMin := time.Duration(d.Get("retry_wait_min").(int)) * time.Second
+	retryWaitMax := time.Duration(d.Get("retry_wait_max").(int)) * time.Second
+
+	maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
+	requestsPerSecond := d.Get("requests_per_second").(float64)
+
+	limited := newLimitedTransport(logging.NewTransport("Netbox", transport), maxConcurrentRequests, requestsPerSecond)
+
 	t := runtimeclient.New(u.Host, u.Path, []string{u.Scheme})
-	t.Transport = logging.NewTransport("Netbox", t.Transport)
+	t.Transport = newRetryTransport(limited, maxRetries, retryWaitMin, retryWaitMax)
 
 	if token != "" {
 		t.DefaultAuthentication = runtimeclient.APIKeyAuth("Authorization", "header",
@@ -0,0 +1,157 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/models"
+)
+
+// netboxRequestError is returned by netboxRequest for unsuccessful responses.
+// It carries the decoded payload like the go-netbox default responses do, so
+// classifyError and apiErrorDiags handle it the same way.
+type netboxRequestError struct {
+	method  string
+	path    string
+	code    int
+	payload interface{}
+}
+
+func (e *netboxRequestError) Error() string {
+	return fmt.Sprintf("[%s %s][%d] %+v", e.method, e.path, e.code, e.payload)
+}
+
+func (e *netboxRequestError) Code() int {
+	return e.code
+}
+
+func (e *netboxRequestError) GetPayload() interface{} {
+	return e.payload
+}
+
+// netboxRequest sends a request through the client's transport, so that it
+// gets the same authentication, retries and rate limiting as the go-netbox
+// operations. It is used for endpoints go-netbox doesn't cover and for fields
+// its models drop, such as false booleans tagged omitempty. Resources send
+// their request bodies as maps through it, so that removed references and
+// emptied attributes are sent as null or empty values and cleared in NetBox.
+// When result is not nil the response body is decoded into it. The path may
+// carry a query string.
+func netboxRequest(ctx context.Context, c *client.NetBoxAPI, method, path string, body, result interface{}) error {
+	pathPattern, rawQuery := path, ""
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		pathPattern, rawQuery = path[:i], path[i+1:]
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Transport.Submit(&runtime.ClientOperation{
+		ID:                 method + " " + pathPattern,
+		Method:             method,
+		PathPattern:        pathPattern,
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params: runtime.ClientRequestWriterFunc(func(r runtime.ClientRequest, reg strfmt.Registry) error {
+			for k, v := range query {
+				if err := r.SetQueryParam(k, v...); err != nil {
+					return err
+				}
+			}
+
+			if body == nil {
+				return nil
+			}
+
+			return r.SetBodyParam(body)
+		}),
+		Reader: runtime.ClientResponseReaderFunc(func(resp runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+			if resp.Code() >= 200 && resp.Code() < 300 {
+				if result == nil || resp.Code() == 204 {
+					return nil, nil
+				}
+
+				if err := consumer.Consume(resp.Body(), result); err != nil && err != io.EOF {
+					return nil, err
+				}
+
+				return nil, nil
+			}
+
+			var payload interface{}
+			if err := consumer.Consume(resp.Body(), &payload); err != nil && err != io.EOF {
+				payload = resp.Message()
+			}
+
+			return nil, &netboxRequestError{method: method, path: path, code: resp.Code(), payload: payload}
+		}),
+		Context: ctx,
+	})
+
+	return err
+}
+
+// requestTags returns the tags for a netboxRequest body. Unlike expandTags it
+// returns an empty list instead of nil, as NetBox rejects null tags and an
+// empty list is needed to remove all tags from an object.
+func requestTags(input []interface{}) []*models.NestedTag {
+	if tags := expandTags(input); tags != nil {
+		return tags
+	}
+
+	return []*models.NestedTag{}
+}
+
+// netboxExtras decodes the tags and custom fields of objects whose go-netbox
+// model lacks them. NetBox leaves the keys out for object types it doesn't
+// support tags or custom fields on, which leaves the fields nil.
+type netboxExtras struct {
+	Tags         *[]*models.NestedTag `json:"tags"`
+	CustomFields interface{}          `json:"custom_fields"`
+}
+
+// setExtras sets the tags and custom_fields attributes from extras. When they
+// are configured but the NetBox server doesn't support them on kind, an error
+// is returned instead of a diff that never goes away.
+func setExtras(d *schema.ResourceData, kind string, extras netboxExtras) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, ok := d.GetOk("tags"); ok && extras.Tags == nil {
+		diags = append(diags, extrasNotSupported("tags", kind))
+	}
+
+	if extras.Tags != nil {
+		d.Set("tags", flattenTags(*extras.Tags))
+	} else {
+		d.Set("tags", nil)
+	}
+
+	if _, ok := d.GetOk("custom_fields"); ok && extras.CustomFields == nil {
+		diags = append(diags, extrasNotSupported("custom_fields", kind))
+	}
+
+	d.Set("custom_fields", flattenCustomFields(extras.CustomFields))
+
+	return diags
+}
+
+func extrasNotSupported(attr, kind string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       fmt.Sprintf("%s are not supported on %s by this NetBox server", attr, kind),
+		Detail:        fmt.Sprintf("NetBox did not return %s for the object, as its version does not support them on %s. Remove %s from the configuration or upgrade NetBox.", attr, kind, attr),
+		AttributePath: cty.GetAttrPath(attr),
+	}
+}
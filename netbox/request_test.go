@@ -0,0 +1,139 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	runtimeclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func requestTestClient(t *testing.T, handler http.HandlerFunc) *client.NetBoxAPI {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client.New(runtimeclient.New(u.Host, "/api", []string{u.Scheme}), strfmt.Default)
+}
+
+func TestNetboxRequest(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/api/dcim/device-types/3/" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+
+		if !reflect.DeepEqual(body, map[string]interface{}{"is_full_depth": false}) {
+			t.Errorf("unexpected body %#v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":3,"is_full_depth":false}`))
+	})
+
+	var result struct {
+		ID          int64 `json:"id"`
+		IsFullDepth bool  `json:"is_full_depth"`
+	}
+
+	err := netboxRequest(context.Background(), c, "PATCH", "/dcim/device-types/3/", map[string]interface{}{"is_full_depth": false}, &result)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if result.ID != 3 || result.IsFullDepth {
+		t.Fatalf("unexpected result %#v", result)
+	}
+}
+
+func TestNetboxRequest_query(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/dcim/platforms/" || r.URL.Query().Get("slug") != "junos os" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count":1,"results":[{"id":7}]}`))
+	})
+
+	var result dcimPlatformList
+
+	err := netboxRequest(context.Background(), c, "GET", "/dcim/platforms/?slug=junos+os", nil, &result)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if result.Count != 1 || len(result.Results) != 1 || result.Results[0].ID != 7 {
+		t.Fatalf("unexpected result %#v", result)
+	}
+}
+
+func TestNetboxRequest_errors(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/dcim/device-types/4/":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail":"Not found."}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"u_height":["Ensure this value is greater than or equal to 0."]}`))
+		}
+	})
+
+	err := netboxRequest(context.Background(), c, "GET", "/dcim/device-types/4/", nil, nil)
+	if !isNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	err = netboxRequest(context.Background(), c, "PATCH", "/dcim/device-types/3/", map[string]interface{}{"u_height": -1}, nil)
+	if classifyError(err) != errorKindValidation {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+
+	diags := apiErrorDiags("Unable to update device type", err, resourceDcimDeviceType().Schema)
+	if len(diags) != 1 || !diags[0].AttributePath.Equals(cty.GetAttrPath("u_height")) {
+		t.Fatalf("expected a diagnostic for u_height, got %#v", diags)
+	}
+}
+
+func TestRequestTags(t *testing.T) {
+	if tags := expandTags(nil); tags != nil {
+		t.Fatalf("expected expandTags to return nil for models tagged omitempty, got %#v", tags)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"tags": requestTags(nil)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(body) != `{"tags":[]}` {
+		t.Fatalf("expected removed tags to be sent as an empty list, got %s", body)
+	}
+
+	tags := requestTags([]interface{}{
+		map[string]interface{}{"name": "Production", "slug": "production"},
+	})
+
+	if len(tags) != 1 || *tags[0].Slug != "production" {
+		t.Fatalf("unexpected tags %#v", tags)
+	}
+}
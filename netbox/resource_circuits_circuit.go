@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/circuits"
@@ -23,6 +22,10 @@ func resourceCircuitsCircuit() *schema.Resource {
 		UpdateContext: resourceCircuitsCircuitUpdate,
 		DeleteContext: resourceCircuitsCircuitDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cid": {
 				Type:     schema.TypeString,
@@ -173,7 +176,7 @@ func resourceCircuitsCircuitCreate(ctx context.Context, d *schema.ResourceData,
 
 	resp, err := c.Circuits.CircuitsCircuitsCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create circuit: %v", err)
+		return apiErrorDiags("Unable to create circuit", err, resourceCircuitsCircuit().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -200,12 +203,12 @@ func resourceCircuitsCircuitRead(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := c.Circuits.CircuitsCircuitsRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get circuit: %v", err)
+		return apiErrorDiags("Unable to get circuit", err, nil)
 	}
 
 	d.Set("cid", resp.Payload.Cid)
@@ -235,7 +238,7 @@ func resourceCircuitsCircuitRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
 
 	return diags
 }
@@ -303,7 +306,7 @@ func resourceCircuitsCircuitUpdate(ctx context.Context, d *schema.ResourceData,
 
 	_, err = c.Circuits.CircuitsCircuitsPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update circuit: %v", err)
+		return apiErrorDiags("Unable to update circuit", err, resourceCircuitsCircuit().Schema)
 	}
 
 	return resourceCircuitsCircuitRead(ctx, d, m)
@@ -325,8 +328,8 @@ func resourceCircuitsCircuitDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	_, err = c.Circuits.CircuitsCircuitsDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete cicruit: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete circuit", err, nil)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -29,6 +28,11 @@ func TestAccCircuitsCircuit_basic(t *testing.T) {
 					testAccCheckCircuitsCircuitExists("netbox_circuits_circuit.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_circuits_circuit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -53,7 +57,7 @@ func testAccCheckCircuitsCircuitDestroy(s *terraform.State) error {
 
 		resp, err := c.Circuits.CircuitsCircuitsRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/circuits"
@@ -22,6 +21,10 @@ func resourceCircuitsProvider() *schema.Resource {
 		UpdateContext: resourceCircuitsProviderUpdate,
 		DeleteContext: resourceCircuitsProviderDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -151,7 +154,7 @@ func resourceCircuitsProviderCreate(ctx context.Context, d *schema.ResourceData,
 
 	resp, err := c.Circuits.CircuitsProvidersCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create circuit: %v", err)
+		return apiErrorDiags("Unable to create provider", err, resourceCircuitsProvider().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -178,12 +181,12 @@ func resourceCircuitsProviderRead(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := c.Circuits.CircuitsProvidersRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get provider: %v", err)
+		return apiErrorDiags("Unable to get provider", err, nil)
 	}
 
 	d.Set("name", resp.Payload.Name)
@@ -211,7 +214,7 @@ func resourceCircuitsProviderRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
 
 	return diags
 }
@@ -273,7 +276,7 @@ func resourceCircuitsProviderUpdate(ctx context.Context, d *schema.ResourceData,
 
 	_, err = c.Circuits.CircuitsProvidersPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update provider: %v", err)
+		return apiErrorDiags("Unable to update provider", err, resourceCircuitsProvider().Schema)
 	}
 
 	return resourceCircuitsProviderRead(ctx, d, m)
@@ -295,8 +298,8 @@ func resourceCircuitsProviderDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	_, err = c.Circuits.CircuitsProvidersDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete provider: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete provider", err, nil)
 	}
 
 	d.SetId("")
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -28,6 +27,11 @@ func TestAccCircuitsProvider_basic(t *testing.T) {
 					testAccCheckCircuitsProviderExists("netbox_circuits_provider.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_circuits_provider.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -52,7 +56,7 @@ func testAccCheckCircuitsProviderDestroy(s *terraform.State) error {
 
 		resp, err := c.Circuits.CircuitsProvidersRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
@@ -0,0 +1,385 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dcimCable mirrors the cable representation of the NetBox API. go-netbox
+// decodes the nested terminations as string maps, which fails for every
+// real response, so cables are managed through netboxRequest.
+type dcimCable struct {
+	ID               int64                   `json:"id"`
+	TerminationAType string                  `json:"termination_a_type"`
+	TerminationAID   int64                   `json:"termination_a_id"`
+	TerminationBType string                  `json:"termination_b_type"`
+	TerminationBID   int64                   `json:"termination_b_id"`
+	Type             string                  `json:"type"`
+	Status           *models.CableStatus     `json:"status"`
+	Label            string                  `json:"label"`
+	Color            string                  `json:"color"`
+	Length           *int64                  `json:"length"`
+	LengthUnit       *models.CableLengthUnit `json:"length_unit"`
+	Tags             []*models.NestedTag     `json:"tags"`
+}
+
+// dcimCableCompatibleTerminations lists the termination types each
+// termination type can be cabled to, as enforced by NetBox.
+var dcimCableCompatibleTerminations = map[string][]string{
+	"circuits.circuittermination": {"dcim.interface", "dcim.frontport", "dcim.rearport", "circuits.circuittermination"},
+	"dcim.consoleport":            {"dcim.consoleserverport", "dcim.frontport", "dcim.rearport"},
+	"dcim.consoleserverport":      {"dcim.consoleport", "dcim.frontport", "dcim.rearport"},
+	"dcim.interface":              {"circuits.circuittermination", "dcim.interface", "dcim.frontport", "dcim.rearport"},
+	"dcim.frontport":              {"circuits.circuittermination", "dcim.consoleport", "dcim.consoleserverport", "dcim.interface", "dcim.frontport", "dcim.rearport"},
+	"dcim.powerfeed":              {"dcim.powerport"},
+	"dcim.poweroutlet":            {"dcim.powerport"},
+	"dcim.powerport":              {"dcim.poweroutlet", "dcim.powerfeed"},
+	"dcim.rearport":               {"circuits.circuittermination", "dcim.consoleport", "dcim.consoleserverport", "dcim.interface", "dcim.frontport", "dcim.rearport"},
+}
+
+var dcimCableTerminationTypes = []string{
+	"circuits.circuittermination",
+	"dcim.consoleport",
+	"dcim.consoleserverport",
+	"dcim.frontport",
+	"dcim.interface",
+	"dcim.powerfeed",
+	"dcim.poweroutlet",
+	"dcim.powerport",
+	"dcim.rearport",
+}
+
+func dcimCableTerminationsCompatible(a, b string) bool {
+	for _, v := range dcimCableCompatibleTerminations[a] {
+		if v == b {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resourceDcimCable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimCableCreate,
+		ReadContext:   resourceDcimCableRead,
+		UpdateContext: resourceDcimCableUpdate,
+		DeleteContext: resourceDcimCableDelete,
+		CustomizeDiff: resourceDcimCableCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"termination_a_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: stringInSlice(dcimCableTerminationTypes),
+			},
+
+			"termination_a_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"termination_b_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: stringInSlice(dcimCableTerminationTypes),
+			},
+
+			"termination_b_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.WritableCableTypeCat3,
+					models.WritableCableTypeCat5,
+					models.WritableCableTypeCat5e,
+					models.WritableCableTypeCat6,
+					models.WritableCableTypeCat6a,
+					models.WritableCableTypeCat7,
+					models.WritableCableTypeDacActive,
+					models.WritableCableTypeDacPassive,
+					models.WritableCableTypeMrj21Trunk,
+					models.WritableCableTypeCoaxial,
+					models.WritableCableTypeMmf,
+					models.WritableCableTypeMmfOm1,
+					models.WritableCableTypeMmfOm2,
+					models.WritableCableTypeMmfOm3,
+					models.WritableCableTypeMmfOm4,
+					models.WritableCableTypeSmf,
+					models.WritableCableTypeSmfOs1,
+					models.WritableCableTypeSmfOs2,
+					models.WritableCableTypeAoc,
+					models.WritableCableTypePower,
+				}),
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  models.WritableCableStatusConnected,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.WritableCableStatusConnected,
+					models.WritableCableStatusPlanned,
+					models.WritableCableStatusDecommissioning,
+				}),
+			},
+
+			"label": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 100),
+			},
+
+			"color": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isHexColor,
+			},
+
+			"length": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intAtLeast(1),
+			},
+
+			"length_unit": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.WritableCableLengthUnitM,
+					models.WritableCableLengthUnitCm,
+					models.WritableCableLengthUnitFt,
+					models.WritableCableLengthUnitIn,
+				}),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimCableCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("termination_a_type") && d.NewValueKnown("termination_b_type") {
+		a := d.Get("termination_a_type").(string)
+		b := d.Get("termination_b_type").(string)
+
+		if !dcimCableTerminationsCompatible(a, b) {
+			return fmt.Errorf("termination_b_type %q can't be cabled to termination_a_type %q", b, a)
+		}
+
+		if a == b && d.NewValueKnown("termination_a_id") && d.NewValueKnown("termination_b_id") &&
+			d.Get("termination_a_id").(int) == d.Get("termination_b_id").(int) {
+			return fmt.Errorf("termination_a and termination_b must not be the same %s", a)
+		}
+	}
+
+	if d.NewValueKnown("length") && d.NewValueKnown("length_unit") {
+		if d.Get("length").(int) != 0 && d.Get("length_unit").(string) == "" {
+			return fmt.Errorf("length_unit must be set when length is set")
+		}
+	}
+
+	return nil
+}
+
+func resourceDcimCableCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	data := map[string]interface{}{
+		"termination_a_type": d.Get("termination_a_type").(string),
+		"termination_a_id":   d.Get("termination_a_id").(int),
+		"termination_b_type": d.Get("termination_b_type").(string),
+		"termination_b_id":   d.Get("termination_b_id").(int),
+		"status":             d.Get("status").(string),
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		data["type"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("label"); ok {
+		data["label"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("color"); ok {
+		data["color"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("length"); ok {
+		data["length"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("length_unit"); ok {
+		data["length_unit"] = v.(string)
+	}
+
+	if tags := expandTags(d.Get("tags").([]interface{})); tags != nil {
+		data["tags"] = tags
+	}
+
+	var result dcimCable
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/cables/", data, &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create cable", err, resourceDcimCable().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimCableRead(ctx, d, m)
+}
+
+func resourceDcimCableRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result dcimCable
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/cables/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get cable", err, nil)
+	}
+
+	d.Set("termination_a_type", result.TerminationAType)
+	d.Set("termination_a_id", result.TerminationAID)
+	d.Set("termination_b_type", result.TerminationBType)
+	d.Set("termination_b_id", result.TerminationBID)
+	d.Set("type", result.Type)
+
+	if result.Status != nil {
+		d.Set("status", result.Status.Value)
+	}
+
+	d.Set("label", result.Label)
+	d.Set("color", result.Color)
+
+	if result.Length != nil {
+		d.Set("length", result.Length)
+	} else {
+		d.Set("length", nil)
+	}
+
+	if result.LengthUnit != nil {
+		d.Set("length_unit", result.LengthUnit.Value)
+	} else {
+		d.Set("length_unit", "")
+	}
+
+	d.Set("tags", flattenTags(result.Tags))
+
+	return diags
+}
+
+func resourceDcimCableUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	data := map[string]interface{}{
+		"status": d.Get("status").(string),
+	}
+
+	for _, k := range []string{"type", "label", "color", "length_unit"} {
+		if d.HasChange(k) {
+			data[k] = d.Get(k).(string)
+		}
+	}
+
+	if d.HasChange("length") {
+		if v, ok := d.GetOk("length"); ok {
+			data["length"] = v.(int)
+		} else {
+			data["length"] = nil
+		}
+	}
+
+	if d.HasChange("tags") {
+		data["tags"] = requestTags(d.Get("tags").([]interface{}))
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/cables/%d/", objectID), data, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update cable", err, resourceDcimCable().Schema)
+	}
+
+	return resourceDcimCableRead(ctx, d, m)
+}
+
+func resourceDcimCableDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/dcim/cables/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete cable", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
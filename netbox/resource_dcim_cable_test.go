@@ -0,0 +1,166 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestDcimCableTerminationsCompatible(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"dcim.interface", "dcim.interface", true},
+		{"dcim.interface", "circuits.circuittermination", true},
+		{"dcim.frontport", "dcim.consoleport", true},
+		{"dcim.powerport", "dcim.powerfeed", true},
+		{"dcim.interface", "dcim.powerport", false},
+		{"dcim.consoleport", "dcim.consoleport", false},
+		{"dcim.poweroutlet", "dcim.poweroutlet", false},
+		{"dcim.unknown", "dcim.interface", false},
+	}
+
+	for _, tc := range cases {
+		if got := dcimCableTerminationsCompatible(tc.a, tc.b); got != tc.want {
+			t.Errorf("dcimCableTerminationsCompatible(%q, %q) = %t, expected %t", tc.a, tc.b, got, tc.want)
+		}
+	}
+
+	// A cable can be declared in either direction, so the table must be
+	// symmetric.
+	for a, compatible := range dcimCableCompatibleTerminations {
+		for _, b := range compatible {
+			if !dcimCableTerminationsCompatible(b, a) {
+				t.Errorf("%q is compatible with %q but not the other way around", a, b)
+			}
+		}
+	}
+}
+
+func TestAccDcimCable_basic(t *testing.T) {
+	label := "test-cable"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimCableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimCableConfigBasic(label),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimCableExists("netbox_dcim_cable.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_cable.test", "termination_a_id", "netbox_dcim_interface.test-cable-a", "id"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_cable.test", "termination_b_id", "netbox_dcim_interface.test-cable-b", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_cable.test", "status", "connected"),
+					resource.TestCheckResourceAttr("netbox_dcim_cable.test", "length", "3"),
+					resource.TestCheckResourceAttr("netbox_dcim_cable.test", "length_unit", "m"),
+				),
+			},
+			{
+				Config: testAccCheckDcimCableConfigUpdate(label),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_cable.test", "status", "planned"),
+					resource.TestCheckResourceAttr("netbox_dcim_cable.test", "color", ""),
+					resource.TestCheckResourceAttr("netbox_dcim_cable.test", "length_unit", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_cable.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimCableDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_cable" {
+			continue
+		}
+
+		var result dcimCable
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/cables/%s/", rs.Primary.ID), nil, &result)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Cable ID still exists: %d", result.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimCableExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cable ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/cables/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+var testAccDcimCableDevice = testAccDcimDeviceConfig("test-cable") + `
+resource "netbox_dcim_interface" "test-cable-a" {
+  device_id = netbox_dcim_device.test-cable.id
+  name      = "eth0"
+  type      = "1000base-t"
+}
+
+resource "netbox_dcim_interface" "test-cable-b" {
+  device_id = netbox_dcim_device.test-cable.id
+  name      = "eth1"
+  type      = "1000base-t"
+}
+`
+
+func testAccCheckDcimCableConfigBasic(label string) string {
+	return testAccDcimCableDevice + fmt.Sprintf(`
+resource "netbox_dcim_cable" "test" {
+  termination_a_type = "dcim.interface"
+  termination_a_id   = netbox_dcim_interface.test-cable-a.id
+  termination_b_type = "dcim.interface"
+  termination_b_id   = netbox_dcim_interface.test-cable-b.id
+  type               = "cat6"
+  label              = "%s"
+  color              = "2196f3"
+  length             = 3
+  length_unit        = "m"
+}
+`, label)
+}
+
+func testAccCheckDcimCableConfigUpdate(label string) string {
+	return testAccDcimCableDevice + fmt.Sprintf(`
+resource "netbox_dcim_cable" "test" {
+  termination_a_type = "dcim.interface"
+  termination_a_id   = netbox_dcim_interface.test-cable-a.id
+  termination_b_type = "dcim.interface"
+  termination_b_id   = netbox_dcim_interface.test-cable-b.id
+  type               = "cat6"
+  label              = "%s"
+  status             = "planned"
+}
+`, label)
+}
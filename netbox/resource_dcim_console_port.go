@@ -0,0 +1,222 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dcimConsolePort decodes console ports returned by NetBox, shadowing
+// connected_endpoint like dcimInterface does.
+type dcimConsolePort struct {
+	models.ConsolePort
+	ConnectedEndpoint json.RawMessage `json:"connected_endpoint"`
+}
+
+// dcimConsolePortTypes lists the connector types accepted by console ports,
+// console server ports and console port templates.
+var dcimConsolePortTypes = []string{
+	models.WritableConsolePortTypeDe9,
+	models.WritableConsolePortTypeDb25,
+	models.WritableConsolePortTypeRj11,
+	models.WritableConsolePortTypeRj12,
+	models.WritableConsolePortTypeRj45,
+	models.WritableConsolePortTypeUsba,
+	models.WritableConsolePortTypeUsbb,
+	models.WritableConsolePortTypeUsbc,
+	models.WritableConsolePortTypeUsbMinia,
+	models.WritableConsolePortTypeUsbMinib,
+	models.WritableConsolePortTypeUsbMicroa,
+	models.WritableConsolePortTypeUsbMicrob,
+	models.WritableConsolePortTypeOther,
+}
+
+func resourceDcimConsolePort() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimConsolePortCreate,
+		ReadContext:   resourceDcimConsolePortRead,
+		UpdateContext: resourceDcimConsolePortUpdate,
+		DeleteContext: resourceDcimConsolePortDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resolveDeviceComponent("/dcim/console-ports/", "console port")),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 64),
+			},
+
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice(dcimConsolePortTypes),
+			},
+
+			"label": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 64),
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimConsolePortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result dcimConsolePort
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/console-ports/", resourceDcimConsolePortData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create console port", err, resourceDcimConsolePort().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimConsolePortRead(ctx, d, m)
+}
+
+func resourceDcimConsolePortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result dcimConsolePort
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/console-ports/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get console port", err, nil)
+	}
+
+	d.Set("device_id", result.Device.ID)
+	d.Set("name", result.Name)
+
+	if result.Type != nil {
+		d.Set("type", result.Type.Value)
+	} else {
+		d.Set("type", "")
+	}
+
+	d.Set("label", result.Label)
+	d.Set("description", result.Description)
+	d.Set("tags", flattenTags(result.Tags))
+
+	return diags
+}
+
+func resourceDcimConsolePortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/console-ports/%d/", objectID), resourceDcimConsolePortData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update console port", err, resourceDcimConsolePort().Schema)
+	}
+
+	return resourceDcimConsolePortRead(ctx, d, m)
+}
+
+func resourceDcimConsolePortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimConsolePortsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimConsolePortsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete console port", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimConsolePortData returns the request body for creating and
+// updating console ports.
+func resourceDcimConsolePortData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"label":       d.Get("label").(string),
+		"description": d.Get("description").(string),
+		"tags":        requestTags(d.Get("tags").([]interface{})),
+	}
+
+	return data
+}
@@ -0,0 +1,112 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestAccDcimConsolePort_basic(t *testing.T) {
+	name := "con0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimConsolePortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimConsolePortConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimConsolePortExists("netbox_dcim_console_port.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_console_port.test", "device_id", "netbox_dcim_device.test-console-port", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_console_port.test", "type", "rj-45"),
+					resource.TestCheckResourceAttr("netbox_dcim_console_port.test", "label", "Console"),
+				),
+			},
+			{
+				Config: testAccCheckDcimConsolePortConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_console_port.test", "type", "usb-a"),
+					resource.TestCheckResourceAttr("netbox_dcim_console_port.test", "label", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_console_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_console_port.test",
+				ImportState:       true,
+				ImportStateId:     "test-console-port/" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimConsolePortDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_console_port" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/console-ports/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Console port ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimConsolePortExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No console port ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/console-ports/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+func testAccCheckDcimConsolePortConfigBasic(name string) string {
+	return testAccDcimDeviceConfig("test-console-port") + fmt.Sprintf(`
+resource "netbox_dcim_console_port" "test" {
+  device_id = netbox_dcim_device.test-console-port.id
+  name      = "%s"
+  type      = "rj-45"
+  label     = "Console"
+}
+`, name)
+}
+
+func testAccCheckDcimConsolePortConfigUpdate(name string) string {
+	return testAccDcimDeviceConfig("test-console-port") + fmt.Sprintf(`
+resource "netbox_dcim_console_port" "test" {
+  device_id = netbox_dcim_device.test-console-port.id
+  name      = "%s"
+  type      = "usb-a"
+}
+`, name)
+}
@@ -0,0 +1,204 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dcimConsoleServerPort decodes console server ports returned by NetBox,
+// shadowing connected_endpoint like dcimInterface does.
+type dcimConsoleServerPort struct {
+	models.ConsoleServerPort
+	ConnectedEndpoint json.RawMessage `json:"connected_endpoint"`
+}
+
+func resourceDcimConsoleServerPort() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimConsoleServerPortCreate,
+		ReadContext:   resourceDcimConsoleServerPortRead,
+		UpdateContext: resourceDcimConsoleServerPortUpdate,
+		DeleteContext: resourceDcimConsoleServerPortDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resolveDeviceComponent("/dcim/console-server-ports/", "console server port")),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 64),
+			},
+
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice(dcimConsolePortTypes),
+			},
+
+			"label": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 64),
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimConsoleServerPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result dcimConsoleServerPort
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/console-server-ports/", resourceDcimConsoleServerPortData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create console server port", err, resourceDcimConsoleServerPort().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimConsoleServerPortRead(ctx, d, m)
+}
+
+func resourceDcimConsoleServerPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result dcimConsoleServerPort
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/console-server-ports/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get console server port", err, nil)
+	}
+
+	d.Set("device_id", result.Device.ID)
+	d.Set("name", result.Name)
+
+	if result.Type != nil {
+		d.Set("type", result.Type.Value)
+	} else {
+		d.Set("type", "")
+	}
+
+	d.Set("label", result.Label)
+	d.Set("description", result.Description)
+	d.Set("tags", flattenTags(result.Tags))
+
+	return diags
+}
+
+func resourceDcimConsoleServerPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/console-server-ports/%d/", objectID), resourceDcimConsoleServerPortData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update console server port", err, resourceDcimConsoleServerPort().Schema)
+	}
+
+	return resourceDcimConsoleServerPortRead(ctx, d, m)
+}
+
+func resourceDcimConsoleServerPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimConsoleServerPortsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimConsoleServerPortsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete console server port", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimConsoleServerPortData returns the request body for creating and
+// updating console server ports.
+func resourceDcimConsoleServerPortData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"label":       d.Get("label").(string),
+		"description": d.Get("description").(string),
+		"tags":        requestTags(d.Get("tags").([]interface{})),
+	}
+
+	return data
+}
@@ -0,0 +1,111 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestAccDcimConsoleServerPort_basic(t *testing.T) {
+	name := "port1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimConsoleServerPortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimConsoleServerPortConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimConsoleServerPortExists("netbox_dcim_console_server_port.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_console_server_port.test", "device_id", "netbox_dcim_device.test-console-server-port", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_console_server_port.test", "type", "rj-45"),
+					resource.TestCheckResourceAttr("netbox_dcim_console_server_port.test", "description", "Acceptance test"),
+				),
+			},
+			{
+				Config: testAccCheckDcimConsoleServerPortConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_console_server_port.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_console_server_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_console_server_port.test",
+				ImportState:       true,
+				ImportStateId:     "test-console-server-port/" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimConsoleServerPortDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_console_server_port" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/console-server-ports/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Console server port ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimConsoleServerPortExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No console server port ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/console-server-ports/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+func testAccCheckDcimConsoleServerPortConfigBasic(name string) string {
+	return testAccDcimDeviceConfig("test-console-server-port") + fmt.Sprintf(`
+resource "netbox_dcim_console_server_port" "test" {
+  device_id   = netbox_dcim_device.test-console-server-port.id
+  name        = "%s"
+  type        = "rj-45"
+  description = "Acceptance test"
+}
+`, name)
+}
+
+func testAccCheckDcimConsoleServerPortConfigUpdate(name string) string {
+	return testAccDcimDeviceConfig("test-console-server-port") + fmt.Sprintf(`
+resource "netbox_dcim_console_server_port" "test" {
+  device_id = netbox_dcim_device.test-console-server-port.id
+  name      = "%s"
+  type      = "rj-45"
+}
+`, name)
+}
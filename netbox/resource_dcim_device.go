@@ -2,9 +2,11 @@ package netbox
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/dcim"
 
@@ -14,6 +16,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// dcimDevice decodes devices returned by NetBox. go-netbox models
+// local_context_data as a string and config_context as a map of strings,
+// which fails as soon as a config context with nested data applies to the
+// device, so both are shadowed and devices are read through netboxRequest.
+type dcimDevice struct {
+	models.DeviceWithConfigContext
+	ConfigContext    json.RawMessage `json:"config_context"`
+	LocalContextData json.RawMessage `json:"local_context_data"`
+}
+
+// dcimWritableDevice shadows the tags of the go-netbox model, which drops an
+// empty list and so can't remove all tags from a device.
+type dcimWritableDevice struct {
+	models.WritableDeviceWithConfigContext
+	Tags *[]*models.NestedTag `json:"tags,omitempty"`
+}
+
 func resourceDcimDevices() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDcimDevicesCreate,
@@ -21,6 +40,10 @@ func resourceDcimDevices() *schema.Resource {
 		UpdateContext: resourceDcimDevicesUpdate,
 		DeleteContext: resourceDcimDevicesDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceDcimDeviceResolveName),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"device_type_id": {
 				Type:     schema.TypeInt,
@@ -78,10 +101,10 @@ func resourceDcimDevices() *schema.Resource {
 				ValidateDiagFunc: stringLenBetween(0, 50),
 			},
 
-			// "config_context": {
-			// 	Type:     schema.TypeString,
-			// 	Optional: true,
-			// },
+			"config_context": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 
 			// "display_name": {
 			// 	Type:     schema.TypeString,
@@ -139,14 +162,17 @@ func resourceDcimDevices() *schema.Resource {
 			"vc_position_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
 			},
 			"vc_priority_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
 			},
 			"virtual_chassis_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
 			},
 
 			"tags": {
@@ -197,116 +223,114 @@ func resourceDcimDevicesCreate(ctx context.Context, d *schema.ResourceData, m in
 	var deviceTypeID = int64(d.Get("device_type_id").(int))
 	var siteID = int64(d.Get("site_id").(int))
 
-	params := &dcim.DcimDevicesCreateParams{
-		Context: ctx,
-	}
+	tags := requestTags(d.Get("tags").([]interface{}))
 
-	params.Data = &models.WritableDeviceWithConfigContext{
-		DeviceRole: &deviceRoleID,
-		DeviceType: &deviceTypeID,
-		Site:       &siteID,
-		Tags:       expandTags(d.Get("tags").([]interface{})),
+	data := &dcimWritableDevice{
+		WritableDeviceWithConfigContext: models.WritableDeviceWithConfigContext{
+			DeviceRole: &deviceRoleID,
+			DeviceType: &deviceTypeID,
+			Site:       &siteID,
+		},
+		Tags: &tags,
 	}
 
 	if v, ok := d.GetOk("tenant_id"); ok {
 		tenantID := int64(v.(int))
-		params.Data.Tenant = &tenantID
+		data.Tenant = &tenantID
 	}
 
 	if v, ok := d.GetOk("comments"); ok {
-		params.Data.Comments = v.(string)
+		data.Comments = v.(string)
 	}
 
 	if v, ok := d.GetOk("status"); ok {
-		params.Data.Status = v.(string)
+		data.Status = v.(string)
 	}
 
 	if v, ok := d.GetOk("asset_tag"); ok {
 		assetTag := v.(string)
-		params.Data.AssetTag = &assetTag
+		data.AssetTag = &assetTag
 	}
 
 	if v, ok := d.GetOk("cluster_id"); ok {
 		clusterID := int64(v.(int))
-		params.Data.Cluster = &clusterID
+		data.Cluster = &clusterID
 	}
 
 	if v, ok := d.GetOk("serial"); ok {
-		params.Data.Serial = v.(string)
+		data.Serial = v.(string)
 	}
 
-	// if v, ok := d.GetOk("config_context"); ok {
-	// 	params.Data.ConfigContext = v.(map[string]string)
-	// }
-
 	// if v, ok := d.GetOk("display_name"); ok {
-	// 	params.Data.DisplayName = v.(string)
+	// 	data.DisplayName = v.(string)
 	// }
 
 	if v, ok := d.GetOk("face"); ok {
-		params.Data.Face = v.(string)
+		data.Face = v.(string)
 	}
 
 	// if v, ok := d.GetOk("local_context_data"); ok {
-	// 	params.Data.LocalContextData = v.(*string)
+	// 	data.LocalContextData = v.(*string)
 	// }
 
 	if v, ok := d.GetOk("name"); ok {
 		name := v.(string)
-		params.Data.Name = &name
+		data.Name = &name
 	}
 
 	if v, ok := d.GetOk("parent_device_id"); ok {
-		params.Data.ParentDevice.ID = int64(v.(int))
+		data.ParentDevice.ID = int64(v.(int))
 	}
 
 	if v, ok := d.GetOk("platform_id"); ok {
 		platFormID := int64(v.(int))
-		params.Data.Platform = &platFormID
+		data.Platform = &platFormID
 	}
 
 	if v, ok := d.GetOk("position_id"); ok {
 		positionID := int64(v.(int))
-		params.Data.Position = &positionID
+		data.Position = &positionID
 	}
 	if v, ok := d.GetOk("primary_ip"); ok {
-		params.Data.PrimaryIP = v.(string)
+		data.PrimaryIP = v.(string)
 	}
 	if v, ok := d.GetOk("primary_ip4_id"); ok {
 		pramaryIP4ID := int64(v.(int))
-		params.Data.PrimaryIp4 = &pramaryIP4ID
+		data.PrimaryIp4 = &pramaryIP4ID
 	}
 	if v, ok := d.GetOk("primary_ip6_id"); ok {
 		pramaryIP6ID := int64(v.(int))
-		params.Data.PrimaryIp6 = &pramaryIP6ID
+		data.PrimaryIp6 = &pramaryIP6ID
 	}
 	if v, ok := d.GetOk("rack_id"); ok {
 		rackID := int64(v.(int))
-		params.Data.Rack = &rackID
+		data.Rack = &rackID
 	}
 	if v, ok := d.GetOk("vc_position_id"); ok {
 		vcPositionID := int64(v.(int))
-		params.Data.VcPosition = &vcPositionID
+		data.VcPosition = &vcPositionID
 	}
 	if v, ok := d.GetOk("vc_priority_id"); ok {
 		vcPriorityID := int64(v.(int))
-		params.Data.VcPriority = &vcPriorityID
+		data.VcPriority = &vcPriorityID
 	}
 	if v, ok := d.GetOk("virtual_chassis_id"); ok {
 		vcID := int64(v.(int))
-		params.Data.VirtualChassis = &vcID
+		data.VirtualChassis = &vcID
 	}
 
 	if v, ok := d.GetOk("custom_fields"); ok {
-		params.Data.CustomFields = v.(map[string]interface{})
+		data.CustomFields = v.(map[string]interface{})
 	}
 
-	resp, err := c.Dcim.DcimDevicesCreate(params, nil)
+	var result dcimDevice
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/devices/", data, &result)
 	if err != nil {
-		return diag.Errorf("Unable to create rack: %v", err)
+		return apiErrorDiags("Unable to create device", err, resourceDcimDevices().Schema)
 	}
 
-	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
+	d.SetId(strconv.FormatInt(result.ID, 10))
 
 	resourceDcimDevicesRead(ctx, d, m)
 
@@ -323,111 +347,110 @@ func resourceDcimDevicesRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.Errorf("Unable to parse ID: %v", err)
 	}
 
-	params := &dcim.DcimDevicesReadParams{
-		Context: ctx,
-		ID:      objectID,
-	}
+	var result dcimDevice
 
-	resp, err := c.Dcim.DcimDevicesRead(params, nil)
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/devices/%d/", objectID), nil, &result)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get device: %v", err)
+		return apiErrorDiags("Unable to get device", err, nil)
 	}
 
-	d.Set("site_id", resp.Payload.Site.ID)
-	d.Set("device_type_id", resp.Payload.DeviceType.ID)
-	d.Set("device_role_id", resp.Payload.DeviceRole.ID)
+	d.Set("site_id", result.Site.ID)
+	d.Set("device_type_id", result.DeviceType.ID)
+	d.Set("device_role_id", result.DeviceRole.ID)
 
-	if resp.Payload.Tenant != nil {
-		d.Set("tenant_id", resp.Payload.Tenant.ID)
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
 	}
 
-	if resp.Payload.Comments != "" {
-		d.Set("comments", resp.Payload.Comments)
+	if result.Comments != "" {
+		d.Set("comments", result.Comments)
 	}
 
-	if resp.Payload.Status != nil {
-		d.Set("status", resp.Payload.Status.Value)
+	if result.Status != nil {
+		d.Set("status", result.Status.Value)
 	}
 
-	if resp.Payload.AssetTag != nil {
-		d.Set("asset_tag", resp.Payload.AssetTag)
+	if result.AssetTag != nil {
+		d.Set("asset_tag", result.AssetTag)
 	}
 
-	if resp.Payload.Cluster != nil {
-		d.Set("cluster_id", resp.Payload.Cluster.ID)
+	if result.Cluster != nil {
+		d.Set("cluster_id", result.Cluster.ID)
 	}
 
-	if resp.Payload.Serial != "" {
-		d.Set("serial", resp.Payload.Serial)
+	if result.Serial != "" {
+		d.Set("serial", result.Serial)
 	}
 
-	// if resp.Payload.ConfigContext != nil {
-	// 	d.Set("config_context", resp.Payload.ConfigContext)
-	// }
+	configContext, err := normalizeJSON(string(result.ConfigContext))
+	if err != nil {
+		return diag.Errorf("Unable to parse config_context: %v", err)
+	}
+
+	d.Set("config_context", configContext)
 
-	// if resp.Payload.DisplayName != "" {
-	// 	d.Set("display_name", resp.Payload.DisplayName)
+	// if result.DisplayName != "" {
+	// 	d.Set("display_name", result.DisplayName)
 	// }
 
-	if resp.Payload.Face != nil {
-		d.Set("face", resp.Payload.Face.Value)
+	if result.Face != nil {
+		d.Set("face", result.Face.Value)
 	}
 
-	// if resp.Payload.LocalContextData != nil {
-	// 	d.Set("local_context_data", resp.Payload.LocalContextData)
+	// if result.LocalContextData != nil {
+	// 	d.Set("local_context_data", result.LocalContextData)
 	// }
 
-	if resp.Payload.Name != nil {
-		d.Set("name", resp.Payload.Name)
+	if result.Name != nil {
+		d.Set("name", result.Name)
 	}
 
-	if resp.Payload.ParentDevice != nil {
-		d.Set("parent_device_id", resp.Payload.ParentDevice.ID)
+	if result.ParentDevice != nil {
+		d.Set("parent_device_id", result.ParentDevice.ID)
 	}
 
-	if resp.Payload.Platform != nil {
-		d.Set("platform_id", resp.Payload.Platform.ID)
+	if result.Platform != nil {
+		d.Set("platform_id", result.Platform.ID)
 	}
 
-	if resp.Payload.Position != nil {
-		d.Set("position_id", resp.Payload.Position)
+	if result.Position != nil {
+		d.Set("position_id", result.Position)
 	}
 
-	if resp.Payload.PrimaryIP != nil {
-		d.Set("primary_ip", resp.Payload.PrimaryIP.Address)
+	if result.PrimaryIP != nil {
+		d.Set("primary_ip", result.PrimaryIP.Address)
 	}
 
-	if resp.Payload.PrimaryIp4 != nil {
-		d.Set("primary_ip4_id", resp.Payload.PrimaryIp4.ID)
+	if result.PrimaryIp4 != nil {
+		d.Set("primary_ip4_id", result.PrimaryIp4.ID)
 	}
 
-	if resp.Payload.PrimaryIp6 != nil {
-		d.Set("primary_ip6_id", resp.Payload.PrimaryIp6.ID)
+	if result.PrimaryIp6 != nil {
+		d.Set("primary_ip6_id", result.PrimaryIp6.ID)
 	}
 
-	if resp.Payload.Rack != nil {
-		d.Set("rack_id", resp.Payload.Rack.ID)
+	if result.Rack != nil {
+		d.Set("rack_id", result.Rack.ID)
 	}
 
-	if resp.Payload.VcPosition != nil {
-		d.Set("vc_position_id", resp.Payload.VcPosition)
-	}
+	// Membership may be managed by netbox_dcim_virtual_chassis, so these are
+	// always refreshed, including when the device has left its chassis.
+	d.Set("vc_position_id", result.VcPosition)
+	d.Set("vc_priority_id", result.VcPriority)
 
-	if resp.Payload.VcPriority != nil {
-		d.Set("vc_priority_id", resp.Payload.VcPriority)
+	if result.VirtualChassis != nil {
+		d.Set("virtual_chassis_id", result.VirtualChassis.ID)
+	} else {
+		d.Set("virtual_chassis_id", nil)
 	}
 
-	if resp.Payload.VirtualChassis != nil {
-		d.Set("virtual_chassis_id", resp.Payload.VirtualChassis.ID)
-	}
-
-	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("tags", flattenTags(result.Tags))
+	d.Set("custom_fields", flattenCustomFields(result.CustomFields))
 
 	return diags
 }
@@ -444,125 +467,119 @@ func resourceDcimDevicesUpdate(ctx context.Context, d *schema.ResourceData, m in
 	deviceRoleID := int64(d.Get("device_role_id").(int))
 	siteID := int64(d.Get("site_id").(int))
 
-	params := &dcim.DcimDevicesPartialUpdateParams{
-		Context: ctx,
-		ID:      objectID,
-	}
-
-	params.Data = &models.WritableDeviceWithConfigContext{
-		DeviceType: &deviceTypeID,
-		DeviceRole: &deviceRoleID,
-		Site:       &siteID,
+	data := &dcimWritableDevice{
+		WritableDeviceWithConfigContext: models.WritableDeviceWithConfigContext{
+			DeviceType: &deviceTypeID,
+			DeviceRole: &deviceRoleID,
+			Site:       &siteID,
+		},
 	}
 
 	if d.HasChange("tenant_id") {
 		tenantID := int64(d.Get("tenant_id").(int))
-		params.Data.Tenant = &tenantID
+		data.Tenant = &tenantID
 	}
 
 	if d.HasChange("comments") {
-		params.Data.Comments = d.Get("comments").(string)
+		data.Comments = d.Get("comments").(string)
 	}
 
 	if d.HasChange("status") {
-		params.Data.Status = d.Get("status").(string)
+		data.Status = d.Get("status").(string)
 	}
 
 	if d.HasChange("asset_tag") {
 		aseetTag := d.Get("asset_tag").(string)
-		params.Data.AssetTag = &aseetTag
+		data.AssetTag = &aseetTag
 	}
 
 	if d.HasChange("cluster_id") {
 		clusterID := int64(d.Get("cluster_id").(int))
-		params.Data.Cluster = &clusterID
+		data.Cluster = &clusterID
 	}
 
 	if d.HasChange("serial") {
-		params.Data.Serial = d.Get("serial").(string)
+		data.Serial = d.Get("serial").(string)
 	}
 
-	// if d.HasChange("config_context") {
-	// 	params.Data.ConfigContext = d.Get("config_context").(map[string]string)
-	// }
-
 	// if d.HasChange("display_name") {
-	// 	params.Data.DisplayName = d.Get("display_name").(string)
+	// 	data.DisplayName = d.Get("display_name").(string)
 	// }
 
 	if d.HasChange("face") {
-		params.Data.Face = d.Get("face").(string)
+		data.Face = d.Get("face").(string)
 	}
 
 	// if d.HasChange("local_context_data") {
 	// 	localContextData := d.Get("local_context_data").(string)
-	// 	params.Data.LocalContextData = &localContextData
+	// 	data.LocalContextData = &localContextData
 	// }
 
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
-		params.Data.Name = &name
+		data.Name = &name
 	}
 
 	if d.HasChange("parent_device_id") {
-		params.Data.ParentDevice.ID = int64(d.Get("parent_device_id").(int))
+		data.ParentDevice.ID = int64(d.Get("parent_device_id").(int))
 	}
 
 	if d.HasChange("platform_id") {
 		platformID := int64(d.Get("platform_id").(int))
-		params.Data.Platform = &platformID
+		data.Platform = &platformID
 	}
 
 	if d.HasChange("position_id") {
 		positionID := int64(d.Get("parent_device_id").(int))
-		params.Data.Position = &positionID
+		data.Position = &positionID
 	}
 
 	if d.HasChange("primary_ip") {
-		params.Data.PrimaryIP = d.Get("parent_device_id").(string)
+		data.PrimaryIP = d.Get("parent_device_id").(string)
 	}
 
 	if d.HasChange("primary_ip4_id") {
 		primaryIP4ID := int64(d.Get("primary_ip4_id").(int))
-		params.Data.PrimaryIp4 = &primaryIP4ID
+		data.PrimaryIp4 = &primaryIP4ID
 	}
 
 	if d.HasChange("primary_ip6_id") {
 		primaryIP6ID := int64(d.Get("primary_ip6_id").(int))
-		params.Data.PrimaryIp6 = &primaryIP6ID
+		data.PrimaryIp6 = &primaryIP6ID
 	}
 
 	if d.HasChange("rack_id") {
 		rackID := int64(d.Get("rack_id").(int))
-		params.Data.Rack = &rackID
+		data.Rack = &rackID
 	}
 
 	if d.HasChange("vc_position_id") {
 		vcPositionID := int64(d.Get("vc_position_id").(int))
-		params.Data.VcPosition = &vcPositionID
+		data.VcPosition = &vcPositionID
 	}
 
 	if d.HasChange("vc_priority_id") {
 		vcPriorityID := int64(d.Get("vc_priority_id").(int))
-		params.Data.VcPriority = &vcPriorityID
+		data.VcPriority = &vcPriorityID
 	}
 
 	if d.HasChange("virtual_chassis_id") {
 		vcID := int64(d.Get("virtual_chassis_id").(int))
-		params.Data.VirtualChassis = &vcID
+		data.VirtualChassis = &vcID
 	}
 
 	if d.HasChange("tags") {
-		params.Data.Tags = expandTags(d.Get("tags").([]interface{}))
+		tags := requestTags(d.Get("tags").([]interface{}))
+		data.Tags = &tags
 	}
 
 	if d.HasChange("custom_fields") {
-		params.Data.CustomFields = d.Get("custom_fields").(map[string]interface{})
+		data.CustomFields = d.Get("custom_fields").(map[string]interface{})
 	}
 
-	_, err = c.Dcim.DcimDevicesPartialUpdate(params, nil)
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/devices/%d/", objectID), data, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update rack: %v", err)
+		return apiErrorDiags("Unable to update device", err, resourceDcimDevices().Schema)
 	}
 
 	return resourceDcimDevicesRead(ctx, d, m)
@@ -584,11 +601,38 @@ func resourceDcimDevicesDelete(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	_, err = c.Dcim.DcimDevicesDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete device: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete device", err, nil)
 	}
 
 	d.SetId("")
 
 	return diags
 }
+
+func resourceDcimDeviceResolveName(ctx context.Context, c *client.NetBoxAPI, key string) (int64, error) {
+	site, name, err := splitImportKey(key, "site-slug/device-name")
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		Results []struct {
+			ID int64 `json:"id"`
+		} `json:"results"`
+	}
+
+	query := url.Values{"site": {site}, "name": {name}}
+
+	err = netboxRequest(ctx, c, "GET", "/dcim/devices/?"+query.Encode(), nil, &result)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list devices: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(result.Results))
+	for _, v := range result.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("device", key, ids)
+}
@@ -0,0 +1,226 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dcimDeviceRole decodes device roles returned by NetBox, including the tags
+// and custom fields the go-netbox model lacks.
+type dcimDeviceRole struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Color       string `json:"color"`
+	VMRole      bool   `json:"vm_role"`
+	Description string `json:"description"`
+	netboxExtras
+}
+
+func resourceDcimDeviceRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimDeviceRoleCreate,
+		ReadContext:   resourceDcimDeviceRoleRead,
+		UpdateContext: resourceDcimDeviceRoleUpdate,
+		DeleteContext: resourceDcimDeviceRoleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceDcimDeviceRoleResolveSlug),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 50),
+			},
+
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"color": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: isHexColor,
+			},
+
+			"vm_role": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimDeviceRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result dcimDeviceRole
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/device-roles/", resourceDcimDeviceRoleData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create device role", err, resourceDcimDeviceRole().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimDeviceRoleRead(ctx, d, m)
+}
+
+func resourceDcimDeviceRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result dcimDeviceRole
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/device-roles/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get device role", err, nil)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	d.Set("color", result.Color)
+	d.Set("vm_role", result.VMRole)
+	d.Set("description", result.Description)
+
+	return setExtras(d, "device roles", result.netboxExtras)
+}
+
+func resourceDcimDeviceRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/device-roles/%d/", objectID), resourceDcimDeviceRoleData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update device role", err, resourceDcimDeviceRole().Schema)
+	}
+
+	return resourceDcimDeviceRoleRead(ctx, d, m)
+}
+
+func resourceDcimDeviceRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/dcim/device-roles/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete device role", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimDeviceRoleData returns the request body for creating and
+// updating device roles.
+func resourceDcimDeviceRoleData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"color":       d.Get("color").(string),
+		"vm_role":     d.Get("vm_role").(bool),
+		"description": d.Get("description").(string),
+	}
+
+	if d.HasChange("tags") {
+		data["tags"] = requestTags(d.Get("tags").([]interface{}))
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
+
+func resourceDcimDeviceRoleResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &dcim.DcimDeviceRolesListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Dcim.DcimDeviceRolesList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list device roles: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("device role", slug, ids)
+}
@@ -0,0 +1,228 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func TestResourceDcimDeviceRoleCreate_tags(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == "POST" {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+
+			if body["vm_role"] != false {
+				t.Errorf("expected vm_role false, got %#v", body["vm_role"])
+			}
+
+			tags, _ := json.Marshal(body["tags"])
+			if string(tags) != `[{"name":"Core","slug":"core"}]` {
+				t.Errorf("unexpected tags %s", tags)
+			}
+		}
+
+		w.Write([]byte(`{
+			"id": 6,
+			"name": "Core",
+			"slug": "core",
+			"color": "ff0000",
+			"vm_role": false,
+			"description": "",
+			"tags": [{"id": 2, "name": "Core", "slug": "core", "color": "9e9e9e"}],
+			"custom_fields": {"owner": "netops"}
+		}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceDcimDeviceRole().Schema, map[string]interface{}{
+		"name":    "Core",
+		"slug":    "core",
+		"color":   "ff0000",
+		"vm_role": false,
+		"tags": []interface{}{
+			map[string]interface{}{"name": "Core", "slug": "core"},
+		},
+	})
+
+	if diags := resourceDcimDeviceRoleCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Get("tags.0.id").(int) != 2 {
+		t.Fatalf("expected tag ID 2, got %v", d.Get("tags"))
+	}
+
+	expected := map[string]interface{}{"owner": "netops"}
+	if actual := d.Get("custom_fields").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected custom_fields %#v, got %#v", expected, actual)
+	}
+}
+
+func TestResourceDcimDeviceRoleRead_tagsNotSupported(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id": 6, "name": "Core", "slug": "core", "color": "ff0000", "vm_role": true, "description": ""}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceDcimDeviceRole().Schema, map[string]interface{}{})
+	d.SetId("6")
+
+	if diags := resourceDcimDeviceRoleRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("expected a server without tags on device roles to be read without tags, got %v", diags)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceDcimDeviceRole().Schema, map[string]interface{}{
+		"tags": []interface{}{
+			map[string]interface{}{"name": "Core", "slug": "core"},
+		},
+	})
+	d.SetId("6")
+
+	diags := resourceDcimDeviceRoleRead(context.Background(), d, c)
+	if len(diags) != 1 || !strings.Contains(diags[0].Summary, "tags are not supported on device roles") {
+		t.Fatalf("expected an error about tags not being supported, got %v", diags)
+	}
+}
+
+func TestAccDcimDeviceRole_basic(t *testing.T) {
+	name := "test device role"
+	slug := "test-device-role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimDeviceRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimDeviceRoleConfigBasic(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimDeviceRoleExists("netbox_dcim_device_role.test"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_role.test", "color", "ff0000"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_role.test", "vm_role", "false"),
+				),
+			},
+			{
+				Config: testAccCheckDcimDeviceRoleConfigUpdate(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_device_role.test", "color", "00ff00"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_role.test", "vm_role", "true"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_role.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_device_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_device_role.test",
+				ImportState:       true,
+				ImportStateId:     slug,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimDeviceRoleDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_device_role" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimDeviceRolesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Dcim.DcimDeviceRolesRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Device role ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimDeviceRoleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No device role ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimDeviceRolesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Dcim.DcimDeviceRolesRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDcimDeviceRoleConfigBasic(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_device_role" "test" {
+  name        = "%s"
+  slug        = "%s"
+  color       = "ff0000"
+  vm_role     = false
+  description = "Acceptance test"
+}
+`, name, slug)
+}
+
+func testAccCheckDcimDeviceRoleConfigUpdate(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_device_role" "test" {
+  name  = "%s"
+  slug  = "%s"
+  color = "00ff00"
+}
+`, name, slug)
+}
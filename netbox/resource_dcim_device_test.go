@@ -3,16 +3,47 @@ package netbox
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"net/http"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
-	"github.com/netbox-community/go-netbox/netbox/client/dcim"
 )
 
+func TestResourceDcimDevicesRead_configContext(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": 5,
+			"name": "sw1",
+			"device_type": {"id": 7},
+			"device_role": {"id": 4},
+			"site": {"id": 3},
+			"config_context": {"ntp": {"servers": ["10.0.0.1", "10.0.0.2"]}, "syslog": true},
+			"local_context_data": {"ntp": {"prefer": "10.0.0.1"}},
+			"tags": []
+		}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceDcimDevices().Schema, map[string]interface{}{})
+	d.SetId("5")
+
+	if diags := resourceDcimDevicesRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := `{"ntp":{"servers":["10.0.0.1","10.0.0.2"]},"syslog":true}`
+	if v := d.Get("config_context").(string); v != expected {
+		t.Fatalf("expected config_context %s, got %s", expected, v)
+	}
+
+	if d.Get("site_id").(int) != 3 {
+		t.Fatalf("expected site_id 3, got %v", d.Get("site_id"))
+	}
+}
+
 func TestAccDcimDevice_basic(t *testing.T) {
 	device_type_id := "7"
 	device_role_id := "4"
@@ -28,6 +59,17 @@ func TestAccDcimDevice_basic(t *testing.T) {
 					testAccCheckDcimDeviceExists("netbox_dcim_device.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_dcim_device.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_device.test",
+				ImportState:       true,
+				ImportStateId:     "test-device/test-device",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -40,26 +82,16 @@ func testAccCheckDcimDeviceDestroy(s *terraform.State) error {
 			continue
 		}
 
-		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/devices/%s/", rs.Primary.ID), nil, nil)
 		if err != nil {
-			return err
-		}
-
-		params := &dcim.DcimDevicesReadParams{
-			Context: context.Background(),
-			ID:      objectID,
-		}
-
-		resp, err := c.Dcim.DcimDevicesRead(params, nil)
-		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
 			return err
 		}
 
-		return fmt.Errorf("Device ID still exists: %d", resp.Payload.ID)
+		return fmt.Errorf("Device ID still exists: %s", rs.Primary.ID)
 	}
 
 	return nil
@@ -79,22 +111,7 @@ func testAccCheckDcimDeviceExists(n string) resource.TestCheckFunc {
 
 		c := testAccProvider.Meta().(*client.NetBoxAPI)
 
-		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
-		if err != nil {
-			return err
-		}
-
-		params := &dcim.DcimDevicesReadParams{
-			Context: context.Background(),
-			ID:      objectID,
-		}
-
-		_, err = c.Dcim.DcimDevicesRead(params, nil)
-		if err != nil {
-			return err
-		}
-
-		return nil
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/devices/%s/", rs.Primary.ID), nil, nil)
 	}
 }
 
@@ -125,6 +142,7 @@ resource "netbox_dcim_rack" "test-device" {
 
 }
 resource "netbox_dcim_device" "test" {
+	name = "test-device"
 	device_type_id = "%s"
 	device_role_id = "%s"
 	site_id = netbox_dcim_site.test-device.id
@@ -141,3 +159,39 @@ resource "netbox_dcim_device" "test" {
 
 `, device_type_id, device_role_id)
 }
+
+// testAccDcimDeviceConfig returns the configuration of a device named name,
+// along with the site, manufacturer, device type and role it needs. All of
+// them are named after the device.
+func testAccDcimDeviceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_site" "%[1]s" {
+  name = "%[1]s"
+  slug = "%[1]s"
+}
+
+resource "netbox_dcim_manufacturer" "%[1]s" {
+  name = "%[1]s"
+  slug = "%[1]s"
+}
+
+resource "netbox_dcim_device_type" "%[1]s" {
+  manufacturer_id = netbox_dcim_manufacturer.%[1]s.id
+  model           = "%[1]s"
+  slug            = "%[1]s"
+}
+
+resource "netbox_dcim_device_role" "%[1]s" {
+  name  = "%[1]s"
+  slug  = "%[1]s"
+  color = "ff0000"
+}
+
+resource "netbox_dcim_device" "%[1]s" {
+  name           = "%[1]s"
+  device_type_id = netbox_dcim_device_type.%[1]s.id
+  device_role_id = netbox_dcim_device_role.%[1]s.id
+  site_id        = netbox_dcim_site.%[1]s.id
+}
+`, name)
+}
@@ -0,0 +1,753 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDcimDeviceType() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimDeviceTypeCreate,
+		ReadContext:   resourceDcimDeviceTypeRead,
+		UpdateContext: resourceDcimDeviceTypeUpdate,
+		DeleteContext: resourceDcimDeviceTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceDcimDeviceTypeResolveSlug),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"manufacturer_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"model": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 50),
+			},
+
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"part_number": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 50),
+			},
+
+			"u_height": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: intAtLeast(0),
+			},
+
+			"is_full_depth": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"subdevice_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.WritableDeviceTypeSubdeviceRoleParent,
+					models.WritableDeviceTypeSubdeviceRoleChild,
+				}),
+			},
+
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"interface_template": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: stringLenBetween(1, 64),
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: stringInSlice(dcimInterfaceTypes),
+						},
+						"mgmt_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"label": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: stringLenBetween(0, 64),
+						},
+						"description": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: stringLenBetween(0, 200),
+						},
+					},
+				},
+			},
+
+			"console_port_template": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: stringLenBetween(1, 64),
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: stringInSlice(dcimConsolePortTypes),
+						},
+						"label": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: stringLenBetween(0, 64),
+						},
+						"description": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: stringLenBetween(0, 200),
+						},
+					},
+				},
+			},
+
+			"power_port_template": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: stringLenBetween(1, 64),
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: stringInSlice(dcimPowerPortTypes),
+						},
+						"maximum_draw": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: intAtLeast(1),
+						},
+						"allocated_draw": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: intAtLeast(1),
+						},
+						"label": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: stringLenBetween(0, 64),
+						},
+						"description": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: stringLenBetween(0, 200),
+						},
+					},
+				},
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimDeviceTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	manufacturerID := int64(d.Get("manufacturer_id").(int))
+	model := d.Get("model").(string)
+	slug := d.Get("slug").(string)
+	uHeight := int64(d.Get("u_height").(int))
+
+	params := &dcim.DcimDeviceTypesCreateParams{
+		Context: ctx,
+	}
+
+	params.Data = &models.WritableDeviceType{
+		Manufacturer: &manufacturerID,
+		Model:        &model,
+		Slug:         &slug,
+		UHeight:      &uHeight,
+		IsFullDepth:  d.Get("is_full_depth").(bool),
+		Tags:         expandTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("part_number"); ok {
+		params.Data.PartNumber = v.(string)
+	}
+
+	if v, ok := d.GetOk("subdevice_role"); ok {
+		params.Data.SubdeviceRole = v.(string)
+	}
+
+	if v, ok := d.GetOk("comments"); ok {
+		params.Data.Comments = v.(string)
+	}
+
+	if v, ok := d.GetOk("custom_fields"); ok {
+		params.Data.CustomFields = v.(map[string]interface{})
+	}
+
+	resp, err := c.Dcim.DcimDeviceTypesCreate(params, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to create device type", err, resourceDcimDeviceType().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
+
+	// WritableDeviceType drops is_full_depth when it is false, leaving the
+	// NetBox default of true in place.
+	if !d.Get("is_full_depth").(bool) {
+		if err := resourceDcimDeviceTypePatch(ctx, c, resp.Payload.ID, map[string]interface{}{"is_full_depth": false}); err != nil {
+			return err
+		}
+	}
+
+	if diags := resourceDcimDeviceTypeSyncTemplates(ctx, c, d, resp.Payload.ID); diags.HasError() {
+		return diags
+	}
+
+	return resourceDcimDeviceTypeRead(ctx, d, m)
+}
+
+func resourceDcimDeviceTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimDeviceTypesReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Dcim.DcimDeviceTypesRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get device type", err, nil)
+	}
+
+	if resp.Payload.Manufacturer != nil {
+		d.Set("manufacturer_id", resp.Payload.Manufacturer.ID)
+	}
+
+	d.Set("model", resp.Payload.Model)
+	d.Set("slug", resp.Payload.Slug)
+	d.Set("part_number", resp.Payload.PartNumber)
+	d.Set("u_height", resp.Payload.UHeight)
+	d.Set("is_full_depth", resp.Payload.IsFullDepth)
+	d.Set("comments", resp.Payload.Comments)
+
+	if resp.Payload.SubdeviceRole != nil {
+		d.Set("subdevice_role", resp.Payload.SubdeviceRole.Value)
+	} else {
+		d.Set("subdevice_role", "")
+	}
+
+	d.Set("tags", flattenTags(resp.Payload.Tags))
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
+
+	for _, t := range dcimComponentTemplateTypes {
+		items, err := t.list(ctx, c, d.Id())
+		if err != nil {
+			return apiErrorDiags(fmt.Sprintf("Unable to get %ss", t.name), err, nil)
+		}
+
+		templates := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			delete(item, "id")
+			templates = append(templates, item)
+		}
+
+		d.Set(t.attribute, templates)
+	}
+
+	return diags
+}
+
+func resourceDcimDeviceTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	manufacturerID := int64(d.Get("manufacturer_id").(int))
+	model := d.Get("model").(string)
+	slug := d.Get("slug").(string)
+
+	params := &dcim.DcimDeviceTypesPartialUpdateParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	params.Data = &models.WritableDeviceType{
+		Manufacturer: &manufacturerID,
+		Model:        &model,
+		Slug:         &slug,
+	}
+
+	// Values the go-netbox model omits when they are empty.
+	cleared := make(map[string]interface{})
+
+	if d.HasChange("part_number") {
+		params.Data.PartNumber = d.Get("part_number").(string)
+	}
+
+	if d.HasChange("u_height") {
+		uHeight := int64(d.Get("u_height").(int))
+		params.Data.UHeight = &uHeight
+	}
+
+	if d.HasChange("is_full_depth") {
+		params.Data.IsFullDepth = d.Get("is_full_depth").(bool)
+		if !params.Data.IsFullDepth {
+			cleared["is_full_depth"] = false
+		}
+	}
+
+	if d.HasChange("subdevice_role") {
+		params.Data.SubdeviceRole = d.Get("subdevice_role").(string)
+		if params.Data.SubdeviceRole == "" {
+			cleared["subdevice_role"] = ""
+		}
+	}
+
+	if d.HasChange("comments") {
+		params.Data.Comments = d.Get("comments").(string)
+	}
+
+	if d.HasChange("tags") {
+		params.Data.Tags = expandTags(d.Get("tags").([]interface{}))
+	}
+
+	if d.HasChange("custom_fields") {
+		params.Data.CustomFields = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	_, err = c.Dcim.DcimDeviceTypesPartialUpdate(params, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update device type", err, resourceDcimDeviceType().Schema)
+	}
+
+	if len(cleared) > 0 {
+		if err := resourceDcimDeviceTypePatch(ctx, c, objectID, cleared); err != nil {
+			return err
+		}
+	}
+
+	if diags := resourceDcimDeviceTypeSyncTemplates(ctx, c, d, objectID); diags.HasError() {
+		return diags
+	}
+
+	return resourceDcimDeviceTypeRead(ctx, d, m)
+}
+
+func resourceDcimDeviceTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimDeviceTypesDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimDeviceTypesDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete device type", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimDeviceTypePatch sends fields the go-netbox model can't carry.
+func resourceDcimDeviceTypePatch(ctx context.Context, c *client.NetBoxAPI, id int64, fields map[string]interface{}) diag.Diagnostics {
+	path := fmt.Sprintf("/dcim/device-types/%d/", id)
+
+	if err := netboxRequest(ctx, c, "PATCH", path, fields, nil); err != nil {
+		return apiErrorDiags("Unable to update device type", err, resourceDcimDeviceType().Schema)
+	}
+
+	return nil
+}
+
+// resourceDcimDeviceTypeSyncTemplates reconciles the component templates of
+// the device type with the configuration. Templates are matched by name; a
+// template whose attributes changed is deleted and created again, which is
+// safe because templates only affect devices created afterwards.
+func resourceDcimDeviceTypeSyncTemplates(ctx context.Context, c *client.NetBoxAPI, d *schema.ResourceData, deviceTypeID int64) diag.Diagnostics {
+	for _, t := range dcimComponentTemplateTypes {
+		if !d.HasChange(t.attribute) {
+			continue
+		}
+
+		o, n := d.GetChange(t.attribute)
+		removed := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		if len(removed) > 0 {
+			existing, err := t.list(ctx, c, strconv.FormatInt(deviceTypeID, 10))
+			if err != nil {
+				return apiErrorDiags(fmt.Sprintf("Unable to get %ss", t.name), err, nil)
+			}
+
+			ids := make(map[string]int64, len(existing))
+			for _, item := range existing {
+				ids[item["name"].(string)] = item["id"].(int64)
+			}
+
+			for _, item := range removed {
+				name := item.(map[string]interface{})["name"].(string)
+
+				id, ok := ids[name]
+				if !ok {
+					continue
+				}
+
+				if err := t.delete(ctx, c, id); err != nil && !isNotFound(err) {
+					return apiErrorDiags(fmt.Sprintf("Unable to delete %s %s", t.name, name), err, nil)
+				}
+			}
+		}
+
+		for _, item := range added {
+			values := item.(map[string]interface{})
+
+			if err := t.create(ctx, c, deviceTypeID, values); err != nil {
+				return apiErrorDiags(fmt.Sprintf("Unable to create %s %s", t.name, values["name"]), err, nil)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceDcimDeviceTypeResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &dcim.DcimDeviceTypesListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Dcim.DcimDeviceTypesList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list device types: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("device type", slug, ids)
+}
+
+// dcimComponentTemplateType manages one kind of component template nested in
+// netbox_dcim_device_type. Items are the flattened attributes of a template
+// plus its "id".
+type dcimComponentTemplateType struct {
+	name      string
+	attribute string
+	list      func(ctx context.Context, c *client.NetBoxAPI, deviceTypeID string) ([]map[string]interface{}, error)
+	create    func(ctx context.Context, c *client.NetBoxAPI, deviceTypeID int64, item map[string]interface{}) error
+	delete    func(ctx context.Context, c *client.NetBoxAPI, id int64) error
+}
+
+var dcimComponentTemplateTypes = []dcimComponentTemplateType{
+	{
+		name:      "interface template",
+		attribute: "interface_template",
+		list: func(ctx context.Context, c *client.NetBoxAPI, deviceTypeID string) ([]map[string]interface{}, error) {
+			limit := int64(0)
+			params := &dcim.DcimInterfaceTemplatesListParams{
+				Context:      ctx,
+				DevicetypeID: &deviceTypeID,
+				Limit:        &limit,
+			}
+
+			resp, err := c.Dcim.DcimInterfaceTemplatesList(params, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]map[string]interface{}, 0, len(resp.Payload.Results))
+			for _, v := range resp.Payload.Results {
+				item := map[string]interface{}{
+					"id":          v.ID,
+					"name":        *v.Name,
+					"type":        "",
+					"mgmt_only":   v.MgmtOnly,
+					"label":       v.Label,
+					"description": v.Description,
+				}
+
+				if v.Type != nil && v.Type.Value != nil {
+					item["type"] = *v.Type.Value
+				}
+
+				items = append(items, item)
+			}
+
+			return items, nil
+		},
+		create: func(ctx context.Context, c *client.NetBoxAPI, deviceTypeID int64, item map[string]interface{}) error {
+			name := item["name"].(string)
+			interfaceType := item["type"].(string)
+
+			params := &dcim.DcimInterfaceTemplatesCreateParams{
+				Context: ctx,
+			}
+
+			params.Data = &models.WritableInterfaceTemplate{
+				DeviceType:  &deviceTypeID,
+				Name:        &name,
+				Type:        &interfaceType,
+				MgmtOnly:    item["mgmt_only"].(bool),
+				Label:       item["label"].(string),
+				Description: item["description"].(string),
+			}
+
+			_, err := c.Dcim.DcimInterfaceTemplatesCreate(params, nil)
+
+			return err
+		},
+		delete: func(ctx context.Context, c *client.NetBoxAPI, id int64) error {
+			params := &dcim.DcimInterfaceTemplatesDeleteParams{
+				Context: ctx,
+				ID:      id,
+			}
+
+			_, err := c.Dcim.DcimInterfaceTemplatesDelete(params, nil)
+
+			return err
+		},
+	},
+	{
+		name:      "console port template",
+		attribute: "console_port_template",
+		list: func(ctx context.Context, c *client.NetBoxAPI, deviceTypeID string) ([]map[string]interface{}, error) {
+			limit := int64(0)
+			params := &dcim.DcimConsolePortTemplatesListParams{
+				Context:      ctx,
+				DevicetypeID: &deviceTypeID,
+				Limit:        &limit,
+			}
+
+			resp, err := c.Dcim.DcimConsolePortTemplatesList(params, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]map[string]interface{}, 0, len(resp.Payload.Results))
+			for _, v := range resp.Payload.Results {
+				item := map[string]interface{}{
+					"id":          v.ID,
+					"name":        *v.Name,
+					"type":        "",
+					"label":       v.Label,
+					"description": v.Description,
+				}
+
+				if v.Type != nil && v.Type.Value != nil {
+					item["type"] = *v.Type.Value
+				}
+
+				items = append(items, item)
+			}
+
+			return items, nil
+		},
+		create: func(ctx context.Context, c *client.NetBoxAPI, deviceTypeID int64, item map[string]interface{}) error {
+			name := item["name"].(string)
+
+			params := &dcim.DcimConsolePortTemplatesCreateParams{
+				Context: ctx,
+			}
+
+			params.Data = &models.WritableConsolePortTemplate{
+				DeviceType:  &deviceTypeID,
+				Name:        &name,
+				Type:        item["type"].(string),
+				Label:       item["label"].(string),
+				Description: item["description"].(string),
+			}
+
+			_, err := c.Dcim.DcimConsolePortTemplatesCreate(params, nil)
+
+			return err
+		},
+		delete: func(ctx context.Context, c *client.NetBoxAPI, id int64) error {
+			params := &dcim.DcimConsolePortTemplatesDeleteParams{
+				Context: ctx,
+				ID:      id,
+			}
+
+			_, err := c.Dcim.DcimConsolePortTemplatesDelete(params, nil)
+
+			return err
+		},
+	},
+	{
+		name:      "power port template",
+		attribute: "power_port_template",
+		list: func(ctx context.Context, c *client.NetBoxAPI, deviceTypeID string) ([]map[string]interface{}, error) {
+			limit := int64(0)
+			params := &dcim.DcimPowerPortTemplatesListParams{
+				Context:      ctx,
+				DevicetypeID: &deviceTypeID,
+				Limit:        &limit,
+			}
+
+			resp, err := c.Dcim.DcimPowerPortTemplatesList(params, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]map[string]interface{}, 0, len(resp.Payload.Results))
+			for _, v := range resp.Payload.Results {
+				item := map[string]interface{}{
+					"id":             v.ID,
+					"name":           *v.Name,
+					"type":           "",
+					"maximum_draw":   0,
+					"allocated_draw": 0,
+					"label":          v.Label,
+					"description":    v.Description,
+				}
+
+				if v.Type != nil && v.Type.Value != nil {
+					item["type"] = *v.Type.Value
+				}
+
+				if v.MaximumDraw != nil {
+					item["maximum_draw"] = int(*v.MaximumDraw)
+				}
+
+				if v.AllocatedDraw != nil {
+					item["allocated_draw"] = int(*v.AllocatedDraw)
+				}
+
+				items = append(items, item)
+			}
+
+			return items, nil
+		},
+		create: func(ctx context.Context, c *client.NetBoxAPI, deviceTypeID int64, item map[string]interface{}) error {
+			name := item["name"].(string)
+
+			params := &dcim.DcimPowerPortTemplatesCreateParams{
+				Context: ctx,
+			}
+
+			params.Data = &models.WritablePowerPortTemplate{
+				DeviceType:  &deviceTypeID,
+				Name:        &name,
+				Type:        item["type"].(string),
+				Label:       item["label"].(string),
+				Description: item["description"].(string),
+			}
+
+			if v := int64(item["maximum_draw"].(int)); v > 0 {
+				params.Data.MaximumDraw = &v
+			}
+
+			if v := int64(item["allocated_draw"].(int)); v > 0 {
+				params.Data.AllocatedDraw = &v
+			}
+
+			_, err := c.Dcim.DcimPowerPortTemplatesCreate(params, nil)
+
+			return err
+		},
+		delete: func(ctx context.Context, c *client.NetBoxAPI, id int64) error {
+			params := &dcim.DcimPowerPortTemplatesDeleteParams{
+				Context: ctx,
+				ID:      id,
+			}
+
+			_, err := c.Dcim.DcimPowerPortTemplatesDelete(params, nil)
+
+			return err
+		},
+	},
+}
@@ -0,0 +1,206 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func TestAccDcimDeviceType_basic(t *testing.T) {
+	slug := "test-device-type"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimDeviceTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimDeviceTypeConfigBasic(slug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimDeviceTypeExists("netbox_dcim_device_type.test"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "u_height", "2"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "is_full_depth", "false"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "interface_template.#", "2"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "console_port_template.#", "1"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "power_port_template.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckDcimDeviceTypeConfigUpdate(slug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "u_height", "1"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "is_full_depth", "true"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "subdevice_role", ""),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "interface_template.#", "1"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "console_port_template.#", "0"),
+					resource.TestCheckResourceAttr("netbox_dcim_device_type.test", "power_port_template.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_device_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_device_type.test",
+				ImportState:       true,
+				ImportStateId:     slug,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimDeviceTypeDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_device_type" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimDeviceTypesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Dcim.DcimDeviceTypesRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Device type ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimDeviceTypeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No device type ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimDeviceTypesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Dcim.DcimDeviceTypesRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDcimDeviceTypeConfigBasic(slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_manufacturer" "test-device-type" {
+  name = "test-device-type"
+  slug = "test-device-type"
+}
+
+resource "netbox_dcim_device_type" "test" {
+  manufacturer_id = netbox_dcim_manufacturer.test-device-type.id
+  model           = "Test Device Type"
+  slug            = "%s"
+  part_number     = "TDT-1"
+  u_height        = 2
+  is_full_depth   = false
+  subdevice_role  = "parent"
+
+  interface_template {
+    name      = "mgmt0"
+    type      = "1000base-t"
+    mgmt_only = true
+  }
+
+  interface_template {
+    name  = "Ethernet1/1"
+    type  = "10gbase-x-sfpp"
+    label = "uplink"
+  }
+
+  console_port_template {
+    name = "console"
+    type = "rj-45"
+  }
+
+  power_port_template {
+    name         = "PSU1"
+    type         = "iec-60320-c14"
+    maximum_draw = 500
+  }
+
+  power_port_template {
+    name         = "PSU2"
+    type         = "iec-60320-c14"
+    maximum_draw = 500
+  }
+}
+`, slug)
+}
+
+func testAccCheckDcimDeviceTypeConfigUpdate(slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_manufacturer" "test-device-type" {
+  name = "test-device-type"
+  slug = "test-device-type"
+}
+
+resource "netbox_dcim_device_type" "test" {
+  manufacturer_id = netbox_dcim_manufacturer.test-device-type.id
+  model           = "Test Device Type"
+  slug            = "%s"
+  part_number     = "TDT-1"
+
+  interface_template {
+    name  = "Ethernet1/1"
+    type  = "10gbase-x-sfpp"
+    label = "uplink1"
+  }
+
+  power_port_template {
+    name         = "PSU1"
+    type         = "iec-60320-c14"
+    maximum_draw = 750
+  }
+
+  power_port_template {
+    name         = "PSU2"
+    type         = "iec-60320-c14"
+    maximum_draw = 750
+  }
+}
+`, slug)
+}
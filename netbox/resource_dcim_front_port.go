@@ -0,0 +1,222 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDcimFrontPort() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimFrontPortCreate,
+		ReadContext:   resourceDcimFrontPortRead,
+		UpdateContext: resourceDcimFrontPortUpdate,
+		DeleteContext: resourceDcimFrontPortDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resolveDeviceComponent("/dcim/front-ports/", "front port")),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 64),
+			},
+
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringInSlice(dcimPortTypes),
+			},
+
+			"label": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 64),
+			},
+
+			"rear_port_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"rear_port_position": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: intBetween(1, 64),
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimFrontPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result models.FrontPort
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/front-ports/", resourceDcimFrontPortData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create front port", err, resourceDcimFrontPort().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimFrontPortRead(ctx, d, m)
+}
+
+func resourceDcimFrontPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimFrontPortsReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Dcim.DcimFrontPortsRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get front port", err, nil)
+	}
+
+	result := resp.Payload
+
+	d.Set("device_id", result.Device.ID)
+	d.Set("name", result.Name)
+
+	if result.Type != nil {
+		d.Set("type", result.Type.Value)
+	} else {
+		d.Set("type", "")
+	}
+
+	d.Set("label", result.Label)
+
+	if result.RearPort != nil {
+		d.Set("rear_port_id", result.RearPort.ID)
+	}
+
+	d.Set("rear_port_position", result.RearPortPosition)
+	d.Set("description", result.Description)
+	d.Set("tags", flattenTags(result.Tags))
+
+	return diags
+}
+
+func resourceDcimFrontPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/front-ports/%d/", objectID), resourceDcimFrontPortData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update front port", err, resourceDcimFrontPort().Schema)
+	}
+
+	return resourceDcimFrontPortRead(ctx, d, m)
+}
+
+func resourceDcimFrontPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimFrontPortsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimFrontPortsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete front port", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimFrontPortData returns the request body for creating and updating
+// front ports.
+func resourceDcimFrontPortData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"label":       d.Get("label").(string),
+		"description": d.Get("description").(string),
+		"tags":        requestTags(d.Get("tags").([]interface{})),
+	}
+
+	data["rear_port"] = d.Get("rear_port_id").(int)
+	data["rear_port_position"] = d.Get("rear_port_position").(int)
+
+	return data
+}
@@ -0,0 +1,127 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestAccDcimFrontPort_basic(t *testing.T) {
+	name := "front1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimFrontPortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimFrontPortConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimFrontPortExists("netbox_dcim_front_port.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_front_port.test", "device_id", "netbox_dcim_device.test-front-port", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_front_port.test", "type", "lc"),
+					resource.TestCheckResourceAttr("netbox_dcim_front_port.test", "rear_port_position", "1"),
+				),
+			},
+			{
+				Config: testAccCheckDcimFrontPortConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_front_port.test", "rear_port_position", "2"),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_front_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_front_port.test",
+				ImportState:       true,
+				ImportStateId:     "test-front-port/" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimFrontPortDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_front_port" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/front-ports/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Front port ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimFrontPortExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No front port ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/front-ports/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+func testAccCheckDcimFrontPortConfigBasic(name string) string {
+	return testAccDcimDeviceConfig("test-front-port") + fmt.Sprintf(`
+resource "netbox_dcim_rear_port" "test-front-port" {
+  device_id = netbox_dcim_device.test-front-port.id
+  name      = "rear1"
+  type      = "lc"
+  positions = 2
+}
+
+resource "netbox_dcim_front_port" "test" {
+  device_id    = netbox_dcim_device.test-front-port.id
+  name         = "%s"
+  type         = "lc"
+  rear_port_id = netbox_dcim_rear_port.test-front-port.id
+}
+`, name)
+}
+
+func testAccCheckDcimFrontPortConfigUpdate(name string) string {
+	return testAccDcimDeviceConfig("test-front-port") + fmt.Sprintf(`
+resource "netbox_dcim_rear_port" "test-front-port" {
+  device_id = netbox_dcim_device.test-front-port.id
+  name      = "rear1"
+  type      = "lc"
+  positions = 2
+}
+
+resource "netbox_dcim_front_port" "test" {
+  device_id          = netbox_dcim_device.test-front-port.id
+  name               = "%s"
+  type               = "lc"
+  rear_port_id       = netbox_dcim_rear_port.test-front-port.id
+  rear_port_position = 2
+}
+`, name)
+}
@@ -2,9 +2,10 @@ package netbox
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/dcim"
 
@@ -14,6 +15,92 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// dcimInterface decodes interfaces returned by NetBox. go-netbox types
+// connected_endpoint as a string map, which can't hold the nested object
+// NetBox returns once an interface is cabled, so the field is shadowed and
+// interfaces are read through netboxRequest.
+type dcimInterface struct {
+	models.Interface
+	ConnectedEndpoint json.RawMessage `json:"connected_endpoint"`
+}
+
+// dcimInterfaceTypes lists the interface types accepted by interfaces and
+// interface templates.
+var dcimInterfaceTypes = []string{
+	models.InterfaceTypeValueVirtual,
+	models.InterfaceTypeValueLag,
+	models.InterfaceTypeValueNr100baseTx,
+	models.InterfaceTypeValueNr1000baset,
+	models.InterfaceTypeValueNr25gbaset,
+	models.InterfaceTypeValueNr5gbaset,
+	models.InterfaceTypeValueNr10gbaset,
+	models.InterfaceTypeValueNr10gbaseCx4,
+	models.InterfaceTypeValueNr1000basexGbic,
+	models.InterfaceTypeValueNr1000basexSfp,
+	models.InterfaceTypeValueNr10gbasexSfpp,
+	models.InterfaceTypeValueNr10gbasexXfp,
+	models.InterfaceTypeValueNr10gbasexXenpak,
+	models.InterfaceTypeValueNr10gbasexX2,
+	models.InterfaceTypeValueNr25gbasexSfp28,
+	models.InterfaceTypeValueNr40gbasexQsfpp,
+	models.InterfaceTypeValueNr50gbasexSfp28,
+	models.InterfaceTypeValueNr100gbasexCfp,
+	models.InterfaceTypeValueNr100gbasexCfp2,
+	models.InterfaceTypeValueNr200gbasexCfp2,
+	models.InterfaceTypeValueNr100gbasexCfp4,
+	models.InterfaceTypeValueNr100gbasexCpak,
+	models.InterfaceTypeValueNr100gbasexQsfp28,
+	models.InterfaceTypeValueNr200gbasexQsfp56,
+	models.InterfaceTypeValueNr400gbasexQsfpdd,
+	models.InterfaceTypeValueNr400gbasexOsfp,
+	models.InterfaceTypeValueIeee80211a,
+	models.InterfaceTypeValueIeee80211g,
+	models.InterfaceTypeValueIeee80211n,
+	models.InterfaceTypeValueIeee80211ac,
+	models.InterfaceTypeValueIeee80211ad,
+	models.InterfaceTypeValueIeee80211ax,
+	models.InterfaceTypeValueGsm,
+	models.InterfaceTypeValueCdma,
+	models.InterfaceTypeValueLte,
+	models.InterfaceTypeValueSonetOc3,
+	models.InterfaceTypeValueSonetOc12,
+	models.InterfaceTypeValueSonetOc48,
+	models.InterfaceTypeValueSonetOc192,
+	models.InterfaceTypeValueSonetOc768,
+	models.InterfaceTypeValueSonetOc1920,
+	models.InterfaceTypeValueSonetOc3840,
+	models.InterfaceTypeValueNr1gfcSfp,
+	models.InterfaceTypeValueNr2gfcSfp,
+	models.InterfaceTypeValueNr4gfcSfp,
+	models.InterfaceTypeValueNr8gfcSfpp,
+	models.InterfaceTypeValueNr16gfcSfpp,
+	models.InterfaceTypeValueNr32gfcSfp28,
+	models.InterfaceTypeValueNr128gfcSfp28,
+	models.InterfaceTypeValueInfinibandSdr,
+	models.InterfaceTypeValueInfinibandDdr,
+	models.InterfaceTypeValueInfinibandQdr,
+	models.InterfaceTypeValueInfinibandFdr10,
+	models.InterfaceTypeValueInfinibandFdr,
+	models.InterfaceTypeValueInfinibandEdr,
+	models.InterfaceTypeValueInfinibandHdr,
+	models.InterfaceTypeValueInfinibandNdr,
+	models.InterfaceTypeValueInfinibandXdr,
+	models.InterfaceTypeValueT1,
+	models.InterfaceTypeValueE1,
+	models.InterfaceTypeValueT3,
+	models.InterfaceTypeValueE3,
+	models.InterfaceTypeValueCiscoStackwise,
+	models.InterfaceTypeValueCiscoStackwisePlus,
+	models.InterfaceTypeValueCiscoFlexstack,
+	models.InterfaceTypeValueCiscoFlexstackPlus,
+	models.InterfaceTypeValueJuniperVcp,
+	models.InterfaceTypeValueExtremeSummitstack,
+	models.InterfaceTypeValueExtremeSummitstack128,
+	models.InterfaceTypeValueExtremeSummitstack256,
+	models.InterfaceTypeValueExtremeSummitstack512,
+	models.InterfaceTypeValueOther,
+}
+
 func resourceDcimInterface() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceDcimInterfaceCreate,
@@ -21,6 +108,10 @@ func resourceDcimInterface() *schema.Resource {
 		UpdateContext: resourceDcimInterfaceUpdate,
 		DeleteContext: resourceDcimInterfaceDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resolveDeviceComponent("/dcim/interfaces/", "interface")),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"device_id": {
 				Type:     schema.TypeInt,
@@ -28,82 +119,9 @@ func resourceDcimInterface() *schema.Resource {
 			},
 
 			"type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateDiagFunc: stringInSlice([]string{
-					models.InterfaceTypeValueVirtual,
-					models.InterfaceTypeValueLag,
-					models.InterfaceTypeValueNr100baseTx,
-					models.InterfaceTypeValueNr1000baset,
-					models.InterfaceTypeValueNr25gbaset,
-					models.InterfaceTypeValueNr5gbaset,
-					models.InterfaceTypeValueNr10gbaset,
-					models.InterfaceTypeValueNr10gbaseCx4,
-					models.InterfaceTypeValueNr1000basexGbic,
-					models.InterfaceTypeValueNr1000basexSfp,
-					models.InterfaceTypeValueNr10gbasexSfpp,
-					models.InterfaceTypeValueNr10gbasexXfp,
-					models.InterfaceTypeValueNr10gbasexXenpak,
-					models.InterfaceTypeValueNr10gbasexX2,
-					models.InterfaceTypeValueNr25gbasexSfp28,
-					models.InterfaceTypeValueNr40gbasexQsfpp,
-					models.InterfaceTypeValueNr50gbasexSfp28,
-					models.InterfaceTypeValueNr100gbasexCfp,
-					models.InterfaceTypeValueNr100gbasexCfp2,
-					models.InterfaceTypeValueNr200gbasexCfp2,
-					models.InterfaceTypeValueNr100gbasexCfp4,
-					models.InterfaceTypeValueNr100gbasexCpak,
-					models.InterfaceTypeValueNr100gbasexQsfp28,
-					models.InterfaceTypeValueNr200gbasexQsfp56,
-					models.InterfaceTypeValueNr400gbasexQsfpdd,
-					models.InterfaceTypeValueNr400gbasexOsfp,
-					models.InterfaceTypeValueIeee80211a,
-					models.InterfaceTypeValueIeee80211g,
-					models.InterfaceTypeValueIeee80211n,
-					models.InterfaceTypeValueIeee80211ac,
-					models.InterfaceTypeValueIeee80211ad,
-					models.InterfaceTypeValueIeee80211ax,
-					models.InterfaceTypeValueGsm,
-					models.InterfaceTypeValueCdma,
-					models.InterfaceTypeValueLte,
-					models.InterfaceTypeValueSonetOc3,
-					models.InterfaceTypeValueSonetOc12,
-					models.InterfaceTypeValueSonetOc48,
-					models.InterfaceTypeValueSonetOc192,
-					models.InterfaceTypeValueSonetOc768,
-					models.InterfaceTypeValueSonetOc1920,
-					models.InterfaceTypeValueSonetOc3840,
-					models.InterfaceTypeValueNr1gfcSfp,
-					models.InterfaceTypeValueNr2gfcSfp,
-					models.InterfaceTypeValueNr4gfcSfp,
-					models.InterfaceTypeValueNr8gfcSfpp,
-					models.InterfaceTypeValueNr16gfcSfpp,
-					models.InterfaceTypeValueNr32gfcSfp28,
-					models.InterfaceTypeValueNr128gfcSfp28,
-					models.InterfaceTypeValueInfinibandSdr,
-					models.InterfaceTypeValueInfinibandDdr,
-					models.InterfaceTypeValueInfinibandQdr,
-					models.InterfaceTypeValueInfinibandFdr10,
-					models.InterfaceTypeValueInfinibandFdr,
-					models.InterfaceTypeValueInfinibandEdr,
-					models.InterfaceTypeValueInfinibandHdr,
-					models.InterfaceTypeValueInfinibandNdr,
-					models.InterfaceTypeValueInfinibandXdr,
-					models.InterfaceTypeValueT1,
-					models.InterfaceTypeValueE1,
-					models.InterfaceTypeValueT3,
-					models.InterfaceTypeValueE3,
-					models.InterfaceTypeValueCiscoStackwise,
-					models.InterfaceTypeValueCiscoStackwisePlus,
-					models.InterfaceTypeValueCiscoFlexstack,
-					models.InterfaceTypeValueCiscoFlexstackPlus,
-					models.InterfaceTypeValueJuniperVcp,
-					models.InterfaceTypeValueExtremeSummitstack,
-					models.InterfaceTypeValueExtremeSummitstack128,
-					models.InterfaceTypeValueExtremeSummitstack256,
-					models.InterfaceTypeValueExtremeSummitstack512,
-					models.InterfaceTypeValueOther,
-				}),
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringInSlice(dcimInterfaceTypes),
 			},
 
 			"name": {
@@ -263,7 +281,7 @@ func resourceDcimInterfaceCreate(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := c.Dcim.DcimInterfacesCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create interface: %v", err)
+		return apiErrorDiags("Unable to create interface", err, resourceDcimInterface().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -283,58 +301,55 @@ func resourceDcimInterfaceRead(ctx context.Context, d *schema.ResourceData, m in
 		return diag.Errorf("Unable to parse ID: %v", err)
 	}
 
-	params := &dcim.DcimInterfacesReadParams{
-		Context: ctx,
-		ID:      objectID,
-	}
+	var result dcimInterface
 
-	resp, err := c.Dcim.DcimInterfacesRead(params, nil)
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/interfaces/%d/", objectID), nil, &result)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get interface: %v", err)
+		return apiErrorDiags("Unable to get interface", err, nil)
 	}
 
-	d.Set("device_id", resp.Payload.Device.ID)
-	d.Set("type", resp.Payload.Type.Value)
-	d.Set("name", resp.Payload.Name)
+	d.Set("device_id", result.Device.ID)
+	d.Set("type", result.Type.Value)
+	d.Set("name", result.Name)
 
-	if resp.Payload.ConnectionStatus != nil {
-		d.Set("connection_status", resp.Payload.ConnectionStatus.Value)
+	if result.ConnectionStatus != nil {
+		d.Set("connection_status", result.ConnectionStatus.Value)
 	}
 
-	if resp.Payload.Label != "" {
-		d.Set("label", resp.Payload.Label)
+	if result.Label != "" {
+		d.Set("label", result.Label)
 	}
 
-	if resp.Payload.MacAddress != nil {
-		d.Set("mac_address", resp.Payload.MacAddress)
+	if result.MacAddress != nil {
+		d.Set("mac_address", result.MacAddress)
 	}
 
-	if resp.Payload.Mode != nil {
-		d.Set("mode", resp.Payload.Mode.Value)
+	if result.Mode != nil {
+		d.Set("mode", result.Mode.Value)
 	}
 
-	if resp.Payload.Description != "" {
-		d.Set("description", resp.Payload.Description)
+	if result.Description != "" {
+		d.Set("description", result.Description)
 	}
 
-	if resp.Payload.UntaggedVlan != nil {
-		d.Set("untagged_vlan_id", resp.Payload.UntaggedVlan.ID)
+	if result.UntaggedVlan != nil {
+		d.Set("untagged_vlan_id", result.UntaggedVlan.ID)
 	}
 
-	if resp.Payload.Mtu != nil {
-		d.Set("mtu", resp.Payload.Mtu)
+	if result.Mtu != nil {
+		d.Set("mtu", result.Mtu)
 	}
 
-	d.Set("enabled", resp.Payload.Enabled)
-	d.Set("management_only", resp.Payload.MgmtOnly)
-	d.Set("tagged_vlan", flattenTaggedVlans(resp.Payload.TaggedVlans))
+	d.Set("enabled", result.Enabled)
+	d.Set("management_only", result.MgmtOnly)
+	d.Set("tagged_vlan", flattenTaggedVlans(result.TaggedVlans))
 
-	d.Set("tags", flattenTags(resp.Payload.Tags))
+	d.Set("tags", flattenTags(result.Tags))
 
 	return diags
 }
@@ -351,12 +366,7 @@ func resourceDcimInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m
 	interfaceType := d.Get("type").(string)
 	name := d.Get("name").(string)
 
-	params := &dcim.DcimInterfacesPartialUpdateParams{
-		Context: ctx,
-		ID:      objectID,
-	}
-
-	params.Data = &models.WritableInterface{
+	data := &models.WritableInterface{
 		Device:      &deviceID,
 		Type:        &interfaceType,
 		Name:        &name,
@@ -365,51 +375,53 @@ func resourceDcimInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	if d.HasChange("connection_status") {
 		connectionStatus := d.Get("connection_status").(bool)
-		params.Data.ConnectionStatus = &connectionStatus
+		data.ConnectionStatus = &connectionStatus
 	}
 
 	if d.HasChange("enabled") {
-		params.Data.Enabled = d.Get("enabled").(bool)
+		data.Enabled = d.Get("enabled").(bool)
 	}
 
 	if d.HasChange("management_only") {
-		params.Data.MgmtOnly = d.Get("management_only").(bool)
+		data.MgmtOnly = d.Get("management_only").(bool)
 	}
 
 	if d.HasChange("label") {
-		params.Data.Label = d.Get("label").(string)
+		data.Label = d.Get("label").(string)
 	}
 
 	if d.HasChange("mac_address") {
 		macAddress := d.Get("mac_address").(string)
-		params.Data.MacAddress = &macAddress
+		data.MacAddress = &macAddress
 	}
 
 	if d.HasChange("mode") {
-		params.Data.Mode = d.Get("mode").(string)
+		data.Mode = d.Get("mode").(string)
 	}
 
 	if d.HasChange("description") {
-		params.Data.Description = d.Get("description").(string)
+		data.Description = d.Get("description").(string)
 	}
 
 	if d.HasChange("untagged_vlan_id") {
 		untaggedVlan := int64(d.Get("untagged_vlan_id").(int))
-		params.Data.UntaggedVlan = &untaggedVlan
+		data.UntaggedVlan = &untaggedVlan
 	}
 
 	if d.HasChange("mtu") {
 		mtu := int64(d.Get("mtu").(int))
-		params.Data.Mtu = &mtu
+		data.Mtu = &mtu
 	}
 
 	if d.HasChange("tags") {
-		params.Data.Tags = expandTags(d.Get("tags").([]interface{}))
+		data.Tags = expandTags(d.Get("tags").([]interface{}))
 	}
 
-	_, err = c.Dcim.DcimInterfacesPartialUpdate(params, nil)
+	// The response is discarded rather than decoded into the go-netbox
+	// model, which fails for cabled interfaces.
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/interfaces/%d/", objectID), data, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update interface: %v", err)
+		return apiErrorDiags("Unable to update interface", err, resourceDcimInterface().Schema)
 	}
 
 	return resourceDcimInterfaceRead(ctx, d, m)
@@ -431,8 +443,8 @@ func resourceDcimInterfaceDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	_, err = c.Dcim.DcimInterfacesDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete interface: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete interface", err, nil)
 	}
 
 	d.SetId("")
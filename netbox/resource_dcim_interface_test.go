@@ -3,16 +3,46 @@ package netbox
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/dcim"
 )
 
+func TestResourceDcimInterfaceRead_connected(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": 5,
+			"device": {"id": 1, "name": "switch01"},
+			"name": "eth0",
+			"type": {"value": "1000base-t", "label": "1000BASE-T (1GE)"},
+			"enabled": true,
+			"cable": {"id": 9, "label": ""},
+			"connected_endpoint_type": "dcim.interface",
+			"connected_endpoint": {"id": 6, "device": {"id": 2, "name": "server01"}, "name": "eth0", "cable": 9},
+			"connection_status": {"value": true, "label": "Connected"},
+			"tags": []
+		}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceDcimInterface().Schema, nil)
+	d.SetId("5")
+
+	if diags := resourceDcimInterfaceRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Get("name").(string) != "eth0" || !d.Get("connection_status").(bool) {
+		t.Fatalf("unexpected state %#v", d.State())
+	}
+}
+
 func TestAccDcimInterface_basic(t *testing.T) {
 	name := "test interface"
 
@@ -27,6 +57,17 @@ func TestAccDcimInterface_basic(t *testing.T) {
 					testAccCheckDcimInterfaceExists("netbox_dcim_interface.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_dcim_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_interface.test",
+				ImportState:       true,
+				ImportStateId:     "test-interface/" + name,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -51,7 +92,7 @@ func testAccCheckDcimInterfaceDestroy(s *terraform.State) error {
 
 		resp, err := c.Dcim.DcimInterfacesRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
@@ -118,6 +159,7 @@ resource "netbox_dcim_rack" "test-interface" {
 }
 
 resource "netbox_dcim_device" "test-interface" {
+	name = "test-interface"
 	device_type_id = 7
 	device_role_id = 4
 	site_id = netbox_dcim_site.test-interface.id
@@ -0,0 +1,192 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDcimManufacturer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimManufacturerCreate,
+		ReadContext:   resourceDcimManufacturerRead,
+		UpdateContext: resourceDcimManufacturerUpdate,
+		DeleteContext: resourceDcimManufacturerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceDcimManufacturerResolveSlug),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(0, 50),
+			},
+
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+		},
+	}
+}
+
+func resourceDcimManufacturerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	params := &dcim.DcimManufacturersCreateParams{
+		Context: ctx,
+	}
+
+	params.Data = &models.Manufacturer{
+		Name: &name,
+		Slug: &slug,
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		params.Data.Description = v.(string)
+	}
+
+	resp, err := c.Dcim.DcimManufacturersCreate(params, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to create manufacturer", err, resourceDcimManufacturer().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
+
+	resourceDcimManufacturerRead(ctx, d, m)
+
+	return diags
+}
+
+func resourceDcimManufacturerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimManufacturersReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Dcim.DcimManufacturersRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get manufacturer", err, nil)
+	}
+
+	d.Set("name", resp.Payload.Name)
+	d.Set("slug", resp.Payload.Slug)
+
+	if resp.Payload.Description != "" {
+		d.Set("description", resp.Payload.Description)
+	}
+
+	return diags
+}
+
+func resourceDcimManufacturerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	name := d.Get("name").(string)
+	slug := d.Get("slug").(string)
+
+	params := &dcim.DcimManufacturersPartialUpdateParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	params.Data = &models.Manufacturer{
+		Name: &name,
+		Slug: &slug,
+	}
+
+	if d.HasChange("description") {
+		params.Data.Description = d.Get("description").(string)
+	}
+
+	_, err = c.Dcim.DcimManufacturersPartialUpdate(params, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update manufacturer", err, resourceDcimManufacturer().Schema)
+	}
+
+	return resourceDcimManufacturerRead(ctx, d, m)
+}
+
+func resourceDcimManufacturerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimManufacturersDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimManufacturersDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete manufacturer", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDcimManufacturerResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &dcim.DcimManufacturersListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Dcim.DcimManufacturersList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list manufacturers: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("manufacturer", slug, ids)
+}
@@ -0,0 +1,120 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func TestAccDcimManufacturer_basic(t *testing.T) {
+	name := "test manufacturer"
+	slug := "test-manufacturer"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimManufacturerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimManufacturerConfigBasic(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimManufacturerExists("netbox_dcim_manufacturer.test"),
+					resource.TestCheckResourceAttr("netbox_dcim_manufacturer.test", "slug", "test-manufacturer"),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_manufacturer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_manufacturer.test",
+				ImportState:       true,
+				ImportStateId:     slug,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimManufacturerDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_manufacturer" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimManufacturersReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Dcim.DcimManufacturersRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Manufacturer ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimManufacturerExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No manufacturer ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimManufacturersReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Dcim.DcimManufacturersRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDcimManufacturerConfigBasic(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_manufacturer" "test" {
+  name        = "%s"
+  slug        = "%s"
+  description = "Acceptance test"
+}
+`, name, slug)
+}
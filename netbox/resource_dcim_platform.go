@@ -0,0 +1,296 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dcimPlatform mirrors the platform representation of the NetBox API.
+// go-netbox models napalm_args as a string, while NetBox sends and expects a
+// JSON document and has no tags or custom fields on platforms, so platforms
+// are managed through netboxRequest.
+type dcimPlatform struct {
+	ID           int64                      `json:"id"`
+	Name         string                     `json:"name"`
+	Slug         string                     `json:"slug"`
+	Manufacturer *models.NestedManufacturer `json:"manufacturer"`
+	NapalmDriver string                     `json:"napalm_driver"`
+	NapalmArgs   json.RawMessage            `json:"napalm_args"`
+	Description  string                     `json:"description"`
+	netboxExtras
+}
+
+type dcimPlatformList struct {
+	Count   int64          `json:"count"`
+	Results []dcimPlatform `json:"results"`
+}
+
+func resourceDcimPlatform() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimPlatformCreate,
+		ReadContext:   resourceDcimPlatformRead,
+		UpdateContext: resourceDcimPlatformUpdate,
+		DeleteContext: resourceDcimPlatformDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceDcimPlatformResolveSlug),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 100),
+			},
+
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"manufacturer_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"napalm_driver": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 50),
+			},
+
+			"napalm_args": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isJSON,
+				StateFunc:        jsonStateFunc,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimPlatformCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	data := map[string]interface{}{
+		"name": d.Get("name").(string),
+		"slug": d.Get("slug").(string),
+	}
+
+	if v, ok := d.GetOk("manufacturer_id"); ok {
+		data["manufacturer"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("napalm_driver"); ok {
+		data["napalm_driver"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("napalm_args"); ok {
+		data["napalm_args"] = json.RawMessage(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		data["description"] = v.(string)
+	}
+
+	if d.HasChange("tags") {
+		data["tags"] = requestTags(d.Get("tags").([]interface{}))
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	var result dcimPlatform
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/platforms/", data, &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create platform", err, resourceDcimPlatform().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimPlatformRead(ctx, d, m)
+}
+
+func resourceDcimPlatformRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result dcimPlatform
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/platforms/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get platform", err, nil)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+
+	if result.Manufacturer != nil {
+		d.Set("manufacturer_id", result.Manufacturer.ID)
+	} else {
+		d.Set("manufacturer_id", nil)
+	}
+
+	d.Set("napalm_driver", result.NapalmDriver)
+
+	napalmArgs, err := normalizeJSON(string(result.NapalmArgs))
+	if err != nil {
+		return diag.Errorf("Unable to parse napalm_args: %v", err)
+	}
+
+	d.Set("napalm_args", napalmArgs)
+	d.Set("description", result.Description)
+
+	return setExtras(d, "platforms", result.netboxExtras)
+}
+
+func resourceDcimPlatformUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	data := map[string]interface{}{
+		"name": d.Get("name").(string),
+		"slug": d.Get("slug").(string),
+	}
+
+	if d.HasChange("manufacturer_id") {
+		if v, ok := d.GetOk("manufacturer_id"); ok {
+			data["manufacturer"] = v.(int)
+		} else {
+			data["manufacturer"] = nil
+		}
+	}
+
+	if d.HasChange("napalm_driver") {
+		data["napalm_driver"] = d.Get("napalm_driver").(string)
+	}
+
+	if d.HasChange("napalm_args") {
+		if v, ok := d.GetOk("napalm_args"); ok {
+			data["napalm_args"] = json.RawMessage(v.(string))
+		} else {
+			data["napalm_args"] = nil
+		}
+	}
+
+	if d.HasChange("description") {
+		data["description"] = d.Get("description").(string)
+	}
+
+	if d.HasChange("tags") {
+		data["tags"] = requestTags(d.Get("tags").([]interface{}))
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/platforms/%d/", objectID), data, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update platform", err, resourceDcimPlatform().Schema)
+	}
+
+	return resourceDcimPlatformRead(ctx, d, m)
+}
+
+func resourceDcimPlatformDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/dcim/platforms/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete platform", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceDcimPlatformResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	var result dcimPlatformList
+
+	err := netboxRequest(ctx, c, "GET", "/dcim/platforms/?slug="+url.QueryEscape(slug), nil, &result)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list platforms: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(result.Results))
+	for _, v := range result.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("platform", slug, ids)
+}
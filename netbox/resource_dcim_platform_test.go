@@ -0,0 +1,131 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestAccDcimPlatform_basic(t *testing.T) {
+	name := "test platform"
+	slug := "test-platform"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimPlatformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimPlatformConfigBasic(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimPlatformExists("netbox_dcim_platform.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_platform.test", "manufacturer_id", "netbox_dcim_manufacturer.test", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_platform.test", "napalm_driver", "junos"),
+					resource.TestCheckResourceAttr("netbox_dcim_platform.test", "napalm_args", `{"optional_args":{"port":830},"timeout":60}`),
+				),
+			},
+			{
+				Config: testAccCheckDcimPlatformConfigUpdate(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_platform.test", "napalm_driver", ""),
+					resource.TestCheckResourceAttr("netbox_dcim_platform.test", "napalm_args", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_platform.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_platform.test",
+				ImportState:       true,
+				ImportStateId:     slug,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimPlatformDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_platform" {
+			continue
+		}
+
+		var result dcimPlatform
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/platforms/%s/", rs.Primary.ID), nil, &result)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Platform ID still exists: %d", result.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimPlatformExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No platform ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/platforms/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+func testAccCheckDcimPlatformConfigBasic(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_manufacturer" "test" {
+  name = "test platform manufacturer"
+  slug = "test-platform-manufacturer"
+}
+
+resource "netbox_dcim_platform" "test" {
+  name            = "%s"
+  slug            = "%s"
+  manufacturer_id = netbox_dcim_manufacturer.test.id
+  napalm_driver   = "junos"
+  napalm_args     = <<-EOT
+    {
+      "timeout": 60,
+      "optional_args": {"port": 830}
+    }
+  EOT
+}
+`, name, slug)
+}
+
+func testAccCheckDcimPlatformConfigUpdate(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_manufacturer" "test" {
+  name = "test platform manufacturer"
+  slug = "test-platform-manufacturer"
+}
+
+resource "netbox_dcim_platform" "test" {
+  name        = "%s"
+  slug        = "%s"
+  description = "Acceptance test"
+}
+`, name, slug)
+}
@@ -0,0 +1,296 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDcimPowerFeed() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimPowerFeedCreate,
+		ReadContext:   resourceDcimPowerFeedRead,
+		UpdateContext: resourceDcimPowerFeedUpdate,
+		DeleteContext: resourceDcimPowerFeedDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"power_panel_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"rack_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 100),
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.PowerFeedStatusValueActive,
+					models.PowerFeedStatusValueFailed,
+					models.PowerFeedStatusValueOffline,
+					models.PowerFeedStatusValuePlanned,
+				}),
+				Default: models.PowerFeedStatusValueActive,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.PowerFeedTypeValuePrimary,
+					models.PowerFeedTypeValueRedundant,
+				}),
+				Default: models.PowerFeedTypeValuePrimary,
+			},
+
+			"supply": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.PowerFeedSupplyValueAc,
+					models.PowerFeedSupplyValueDc,
+				}),
+				Default: models.PowerFeedSupplyValueAc,
+			},
+
+			"phase": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.PowerFeedPhaseValueSinglePhase,
+					models.PowerFeedPhaseValueThreePhase,
+				}),
+				Default: models.PowerFeedPhaseValueSinglePhase,
+			},
+
+			"voltage": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intBetween(-32768, 32767),
+				Default:          120,
+			},
+
+			"amperage": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intBetween(1, 32767),
+				Default:          20,
+			},
+
+			"max_utilization": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intBetween(1, 100),
+				Default:          80,
+			},
+
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimPowerFeedCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result models.PowerFeed
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/power-feeds/", resourceDcimPowerFeedData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create power feed", err, resourceDcimPowerFeed().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimPowerFeedRead(ctx, d, m)
+}
+
+func resourceDcimPowerFeedRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimPowerFeedsReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Dcim.DcimPowerFeedsRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get power feed", err, nil)
+	}
+
+	d.Set("power_panel_id", resp.Payload.PowerPanel.ID)
+	d.Set("name", resp.Payload.Name)
+
+	if resp.Payload.Rack != nil {
+		d.Set("rack_id", resp.Payload.Rack.ID)
+	} else {
+		d.Set("rack_id", nil)
+	}
+
+	if resp.Payload.Status != nil {
+		d.Set("status", resp.Payload.Status.Value)
+	}
+
+	if resp.Payload.Type != nil {
+		d.Set("type", resp.Payload.Type.Value)
+	}
+
+	if resp.Payload.Supply != nil {
+		d.Set("supply", resp.Payload.Supply.Value)
+	}
+
+	if resp.Payload.Phase != nil {
+		d.Set("phase", resp.Payload.Phase.Value)
+	}
+
+	d.Set("voltage", resp.Payload.Voltage)
+	d.Set("amperage", resp.Payload.Amperage)
+	d.Set("max_utilization", resp.Payload.MaxUtilization)
+	d.Set("comments", resp.Payload.Comments)
+	d.Set("tags", flattenTags(resp.Payload.Tags))
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
+
+	return diags
+}
+
+func resourceDcimPowerFeedUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/power-feeds/%d/", objectID), resourceDcimPowerFeedData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update power feed", err, resourceDcimPowerFeed().Schema)
+	}
+
+	return resourceDcimPowerFeedRead(ctx, d, m)
+}
+
+func resourceDcimPowerFeedDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimPowerFeedsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimPowerFeedsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete power feed", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimPowerFeedData returns the request body for creating and updating
+// power feeds.
+func resourceDcimPowerFeedData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"power_panel":     d.Get("power_panel_id").(int),
+		"name":            d.Get("name").(string),
+		"status":          d.Get("status").(string),
+		"type":            d.Get("type").(string),
+		"supply":          d.Get("supply").(string),
+		"phase":           d.Get("phase").(string),
+		"voltage":         d.Get("voltage").(int),
+		"amperage":        d.Get("amperage").(int),
+		"max_utilization": d.Get("max_utilization").(int),
+		"comments":        d.Get("comments").(string),
+		"tags":            requestTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("rack_id"); ok {
+		data["rack"] = v.(int)
+	} else {
+		data["rack"] = nil
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
@@ -0,0 +1,167 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func TestAccDcimPowerFeed_basic(t *testing.T) {
+	name := "test power feed"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimPowerFeedDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimPowerFeedConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimPowerFeedExists("netbox_dcim_power_feed.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_power_feed.test", "power_panel_id", "netbox_dcim_power_panel.test-power-feed", "id"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_power_feed.test", "rack_id", "netbox_dcim_rack.test-power-feed", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "status", "planned"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "type", "redundant"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "supply", "ac"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "phase", "three-phase"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "voltage", "400"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "amperage", "32"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "max_utilization", "90"),
+				),
+			},
+			{
+				Config: testAccCheckDcimPowerFeedConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "type", "primary"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "phase", "single-phase"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "voltage", "230"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "amperage", "20"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "max_utilization", "80"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_feed.test", "comments", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_power_feed.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimPowerFeedDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_power_feed" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimPowerFeedsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Dcim.DcimPowerFeedsRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Power feed ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimPowerFeedExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No power feed ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimPowerFeedsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Dcim.DcimPowerFeedsRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDcimPowerFeedConfigBasic(name string) string {
+	return testAccDcimPowerFeedPanel + fmt.Sprintf(`
+resource "netbox_dcim_power_feed" "test" {
+  power_panel_id  = netbox_dcim_power_panel.test-power-feed.id
+  rack_id         = netbox_dcim_rack.test-power-feed.id
+  name            = "%s"
+  status          = "planned"
+  type            = "redundant"
+  phase           = "three-phase"
+  voltage         = 400
+  amperage        = 32
+  max_utilization = 90
+  comments        = "test comments"
+}
+`, name)
+}
+
+func testAccCheckDcimPowerFeedConfigUpdate(name string) string {
+	return testAccDcimPowerFeedPanel + fmt.Sprintf(`
+resource "netbox_dcim_power_feed" "test" {
+  power_panel_id = netbox_dcim_power_panel.test-power-feed.id
+  name           = "%s"
+  voltage        = 230
+}
+`, name)
+}
+
+var testAccDcimPowerFeedPanel = `
+resource "netbox_dcim_site" "test-power-feed" {
+  name = "test-power-feed"
+  slug = "test-power-feed"
+}
+
+resource "netbox_dcim_rack" "test-power-feed" {
+  name    = "test-power-feed"
+  site_id = netbox_dcim_site.test-power-feed.id
+}
+
+resource "netbox_dcim_power_panel" "test-power-feed" {
+  name    = "test-power-feed"
+  site_id = netbox_dcim_site.test-power-feed.id
+}
+`
@@ -0,0 +1,319 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dcimPowerOutlet decodes power outlets returned by NetBox, shadowing
+// connected_endpoint like dcimInterface does.
+type dcimPowerOutlet struct {
+	models.PowerOutlet
+	ConnectedEndpoint json.RawMessage `json:"connected_endpoint"`
+}
+
+// dcimPowerOutletTypes lists the connector types accepted by power outlets.
+var dcimPowerOutletTypes = []string{
+	models.WritablePowerOutletTypeIec60320C5,
+	models.WritablePowerOutletTypeIec60320C7,
+	models.WritablePowerOutletTypeIec60320C13,
+	models.WritablePowerOutletTypeIec60320C15,
+	models.WritablePowerOutletTypeIec60320C19,
+	models.WritablePowerOutletTypeIec60309pne4h,
+	models.WritablePowerOutletTypeIec60309pne6h,
+	models.WritablePowerOutletTypeIec60309pne9h,
+	models.WritablePowerOutletTypeIec603092pe4h,
+	models.WritablePowerOutletTypeIec603092pe6h,
+	models.WritablePowerOutletTypeIec603092pe9h,
+	models.WritablePowerOutletTypeIec603093pe4h,
+	models.WritablePowerOutletTypeIec603093pe6h,
+	models.WritablePowerOutletTypeIec603093pe9h,
+	models.WritablePowerOutletTypeIec603093pne4h,
+	models.WritablePowerOutletTypeIec603093pne6h,
+	models.WritablePowerOutletTypeIec603093pne9h,
+	models.WritablePowerOutletTypeNema115r,
+	models.WritablePowerOutletTypeNema515r,
+	models.WritablePowerOutletTypeNema520r,
+	models.WritablePowerOutletTypeNema530r,
+	models.WritablePowerOutletTypeNema550r,
+	models.WritablePowerOutletTypeNema615r,
+	models.WritablePowerOutletTypeNema620r,
+	models.WritablePowerOutletTypeNema630r,
+	models.WritablePowerOutletTypeNema650r,
+	models.WritablePowerOutletTypeNema1030r,
+	models.WritablePowerOutletTypeNema1050r,
+	models.WritablePowerOutletTypeNema1420r,
+	models.WritablePowerOutletTypeNema1430r,
+	models.WritablePowerOutletTypeNema1450r,
+	models.WritablePowerOutletTypeNema1460r,
+	models.WritablePowerOutletTypeNema1515r,
+	models.WritablePowerOutletTypeNema1520r,
+	models.WritablePowerOutletTypeNema1530r,
+	models.WritablePowerOutletTypeNema1550r,
+	models.WritablePowerOutletTypeNema1560r,
+	models.WritablePowerOutletTypeNemaL115r,
+	models.WritablePowerOutletTypeNemaL515r,
+	models.WritablePowerOutletTypeNemaL520r,
+	models.WritablePowerOutletTypeNemaL530r,
+	models.WritablePowerOutletTypeNemaL550r,
+	models.WritablePowerOutletTypeNemaL615r,
+	models.WritablePowerOutletTypeNemaL620r,
+	models.WritablePowerOutletTypeNemaL630r,
+	models.WritablePowerOutletTypeNemaL650r,
+	models.WritablePowerOutletTypeNemaL1030r,
+	models.WritablePowerOutletTypeNemaL1420r,
+	models.WritablePowerOutletTypeNemaL1430r,
+	models.WritablePowerOutletTypeNemaL1450r,
+	models.WritablePowerOutletTypeNemaL1460r,
+	models.WritablePowerOutletTypeNemaL1520r,
+	models.WritablePowerOutletTypeNemaL1530r,
+	models.WritablePowerOutletTypeNemaL1550r,
+	models.WritablePowerOutletTypeNemaL1560r,
+	models.WritablePowerOutletTypeNemaL2120r,
+	models.WritablePowerOutletTypeNemaL2130r,
+	models.WritablePowerOutletTypeCS6360C,
+	models.WritablePowerOutletTypeCS6364C,
+	models.WritablePowerOutletTypeCS8164C,
+	models.WritablePowerOutletTypeCS8264C,
+	models.WritablePowerOutletTypeCS8364C,
+	models.WritablePowerOutletTypeCS8464C,
+	models.WritablePowerOutletTypeItae,
+	models.WritablePowerOutletTypeItaf,
+	models.WritablePowerOutletTypeItag,
+	models.WritablePowerOutletTypeItah,
+	models.WritablePowerOutletTypeItai,
+	models.WritablePowerOutletTypeItaj,
+	models.WritablePowerOutletTypeItak,
+	models.WritablePowerOutletTypeItal,
+	models.WritablePowerOutletTypeItam,
+	models.WritablePowerOutletTypeItan,
+	models.WritablePowerOutletTypeItao,
+	models.WritablePowerOutletTypeHdotCx,
+}
+
+func resourceDcimPowerOutlet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimPowerOutletCreate,
+		ReadContext:   resourceDcimPowerOutletRead,
+		UpdateContext: resourceDcimPowerOutletUpdate,
+		DeleteContext: resourceDcimPowerOutletDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resolveDeviceComponent("/dcim/power-outlets/", "power outlet")),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 64),
+			},
+
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice(dcimPowerOutletTypes),
+			},
+
+			"label": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 64),
+			},
+
+			"power_port_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"feed_leg": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.WritablePowerOutletFeedLegA,
+					models.WritablePowerOutletFeedLegB,
+					models.WritablePowerOutletFeedLegC,
+				}),
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimPowerOutletCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result dcimPowerOutlet
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/power-outlets/", resourceDcimPowerOutletData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create power outlet", err, resourceDcimPowerOutlet().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimPowerOutletRead(ctx, d, m)
+}
+
+func resourceDcimPowerOutletRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result dcimPowerOutlet
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/power-outlets/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get power outlet", err, nil)
+	}
+
+	d.Set("device_id", result.Device.ID)
+	d.Set("name", result.Name)
+
+	if result.Type != nil {
+		d.Set("type", result.Type.Value)
+	} else {
+		d.Set("type", "")
+	}
+
+	d.Set("label", result.Label)
+
+	if result.PowerPort != nil {
+		d.Set("power_port_id", result.PowerPort.ID)
+	} else {
+		d.Set("power_port_id", nil)
+	}
+
+	if result.FeedLeg != nil {
+		d.Set("feed_leg", result.FeedLeg.Value)
+	} else {
+		d.Set("feed_leg", "")
+	}
+
+	d.Set("description", result.Description)
+	d.Set("tags", flattenTags(result.Tags))
+
+	return diags
+}
+
+func resourceDcimPowerOutletUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/power-outlets/%d/", objectID), resourceDcimPowerOutletData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update power outlet", err, resourceDcimPowerOutlet().Schema)
+	}
+
+	return resourceDcimPowerOutletRead(ctx, d, m)
+}
+
+func resourceDcimPowerOutletDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimPowerOutletsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimPowerOutletsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete power outlet", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimPowerOutletData returns the request body for creating and
+// updating power outlets.
+func resourceDcimPowerOutletData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"label":       d.Get("label").(string),
+		"description": d.Get("description").(string),
+		"tags":        requestTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("power_port_id"); ok {
+		data["power_port"] = v.(int)
+	} else {
+		data["power_port"] = nil
+	}
+
+	data["feed_leg"] = d.Get("feed_leg").(string)
+
+	return data
+}
@@ -0,0 +1,124 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestAccDcimPowerOutlet_basic(t *testing.T) {
+	name := "outlet1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimPowerOutletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimPowerOutletConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimPowerOutletExists("netbox_dcim_power_outlet.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_power_outlet.test", "device_id", "netbox_dcim_device.test-power-outlet", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_outlet.test", "type", "iec-60320-c13"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_outlet.test", "feed_leg", "A"),
+				),
+			},
+			{
+				Config: testAccCheckDcimPowerOutletConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_power_outlet.test", "feed_leg", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_power_outlet.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_power_outlet.test",
+				ImportState:       true,
+				ImportStateId:     "test-power-outlet/" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimPowerOutletDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_power_outlet" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/power-outlets/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Power outlet ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimPowerOutletExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No power outlet ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/power-outlets/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+func testAccCheckDcimPowerOutletConfigBasic(name string) string {
+	return testAccDcimDeviceConfig("test-power-outlet") + fmt.Sprintf(`
+resource "netbox_dcim_power_port" "test-power-outlet" {
+  device_id = netbox_dcim_device.test-power-outlet.id
+  name      = "inlet"
+  type      = "iec-60320-c20"
+}
+
+resource "netbox_dcim_power_outlet" "test" {
+  device_id     = netbox_dcim_device.test-power-outlet.id
+  name          = "%s"
+  type          = "iec-60320-c13"
+  power_port_id = netbox_dcim_power_port.test-power-outlet.id
+  feed_leg      = "A"
+}
+`, name)
+}
+
+func testAccCheckDcimPowerOutletConfigUpdate(name string) string {
+	return testAccDcimDeviceConfig("test-power-outlet") + fmt.Sprintf(`
+resource "netbox_dcim_power_port" "test-power-outlet" {
+  device_id = netbox_dcim_device.test-power-outlet.id
+  name      = "inlet"
+  type      = "iec-60320-c20"
+}
+
+resource "netbox_dcim_power_outlet" "test" {
+  device_id = netbox_dcim_device.test-power-outlet.id
+  name      = "%s"
+  type      = "iec-60320-c13"
+}
+`, name)
+}
@@ -0,0 +1,187 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDcimPowerPanel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimPowerPanelCreate,
+		ReadContext:   resourceDcimPowerPanelRead,
+		UpdateContext: resourceDcimPowerPanelUpdate,
+		DeleteContext: resourceDcimPowerPanelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 100),
+			},
+
+			"site_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"rack_group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimPowerPanelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result models.PowerPanel
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/power-panels/", resourceDcimPowerPanelData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create power panel", err, resourceDcimPowerPanel().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimPowerPanelRead(ctx, d, m)
+}
+
+func resourceDcimPowerPanelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimPowerPanelsReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Dcim.DcimPowerPanelsRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get power panel", err, nil)
+	}
+
+	d.Set("name", resp.Payload.Name)
+	d.Set("site_id", resp.Payload.Site.ID)
+
+	if resp.Payload.RackGroup != nil {
+		d.Set("rack_group_id", resp.Payload.RackGroup.ID)
+	} else {
+		d.Set("rack_group_id", nil)
+	}
+
+	d.Set("tags", flattenTags(resp.Payload.Tags))
+
+	return diags
+}
+
+func resourceDcimPowerPanelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/power-panels/%d/", objectID), resourceDcimPowerPanelData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update power panel", err, resourceDcimPowerPanel().Schema)
+	}
+
+	return resourceDcimPowerPanelRead(ctx, d, m)
+}
+
+func resourceDcimPowerPanelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimPowerPanelsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimPowerPanelsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete power panel", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimPowerPanelData returns the request body for creating and updating
+// power panels.
+func resourceDcimPowerPanelData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name": d.Get("name").(string),
+		"site": d.Get("site_id").(int),
+		"tags": requestTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("rack_group_id"); ok {
+		data["rack_group"] = v.(int)
+	} else {
+		data["rack_group"] = nil
+	}
+
+	return data
+}
@@ -0,0 +1,145 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func TestAccDcimPowerPanel_basic(t *testing.T) {
+	name := "test power panel"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimPowerPanelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimPowerPanelConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimPowerPanelExists("netbox_dcim_power_panel.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_power_panel.test", "site_id", "netbox_dcim_site.test-power-panel", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_panel.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckDcimPowerPanelConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_power_panel.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_power_panel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimPowerPanelDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_power_panel" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimPowerPanelsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Dcim.DcimPowerPanelsRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Power panel ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimPowerPanelExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No power panel ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimPowerPanelsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Dcim.DcimPowerPanelsRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDcimPowerPanelConfigBasic(name string) string {
+	return testAccDcimPowerPanelSite + fmt.Sprintf(`
+resource "netbox_extras_tag" "test-power-panel" {
+  name = "test-power-panel"
+  slug = "test-power-panel"
+}
+
+resource "netbox_dcim_power_panel" "test" {
+  name    = "%s"
+  site_id = netbox_dcim_site.test-power-panel.id
+
+  tags {
+    name = netbox_extras_tag.test-power-panel.name
+    slug = netbox_extras_tag.test-power-panel.slug
+  }
+}
+`, name)
+}
+
+func testAccCheckDcimPowerPanelConfigUpdate(name string) string {
+	return testAccDcimPowerPanelSite + fmt.Sprintf(`
+resource "netbox_dcim_power_panel" "test" {
+  name    = "%s"
+  site_id = netbox_dcim_site.test-power-panel.id
+}
+`, name)
+}
+
+var testAccDcimPowerPanelSite = `
+resource "netbox_dcim_site" "test-power-panel" {
+  name = "test-power-panel"
+  slug = "test-power-panel"
+}
+`
@@ -0,0 +1,310 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dcimPowerPort decodes power ports returned by NetBox, shadowing
+// connected_endpoint like dcimInterface does.
+type dcimPowerPort struct {
+	models.PowerPort
+	ConnectedEndpoint json.RawMessage `json:"connected_endpoint"`
+}
+
+// dcimPowerPortTypes lists the connector types accepted by power ports and
+// power port templates.
+var dcimPowerPortTypes = []string{
+	models.WritablePowerPortTypeIec60320C6,
+	models.WritablePowerPortTypeIec60320C8,
+	models.WritablePowerPortTypeIec60320C14,
+	models.WritablePowerPortTypeIec60320C16,
+	models.WritablePowerPortTypeIec60320C20,
+	models.WritablePowerPortTypeIec60309pne4h,
+	models.WritablePowerPortTypeIec60309pne6h,
+	models.WritablePowerPortTypeIec60309pne9h,
+	models.WritablePowerPortTypeIec603092pe4h,
+	models.WritablePowerPortTypeIec603092pe6h,
+	models.WritablePowerPortTypeIec603092pe9h,
+	models.WritablePowerPortTypeIec603093pe4h,
+	models.WritablePowerPortTypeIec603093pe6h,
+	models.WritablePowerPortTypeIec603093pe9h,
+	models.WritablePowerPortTypeIec603093pne4h,
+	models.WritablePowerPortTypeIec603093pne6h,
+	models.WritablePowerPortTypeIec603093pne9h,
+	models.WritablePowerPortTypeNema115p,
+	models.WritablePowerPortTypeNema515p,
+	models.WritablePowerPortTypeNema520p,
+	models.WritablePowerPortTypeNema530p,
+	models.WritablePowerPortTypeNema550p,
+	models.WritablePowerPortTypeNema615p,
+	models.WritablePowerPortTypeNema620p,
+	models.WritablePowerPortTypeNema630p,
+	models.WritablePowerPortTypeNema650p,
+	models.WritablePowerPortTypeNema1030p,
+	models.WritablePowerPortTypeNema1050p,
+	models.WritablePowerPortTypeNema1420p,
+	models.WritablePowerPortTypeNema1430p,
+	models.WritablePowerPortTypeNema1450p,
+	models.WritablePowerPortTypeNema1460p,
+	models.WritablePowerPortTypeNema1515p,
+	models.WritablePowerPortTypeNema1520p,
+	models.WritablePowerPortTypeNema1530p,
+	models.WritablePowerPortTypeNema1550p,
+	models.WritablePowerPortTypeNema1560p,
+	models.WritablePowerPortTypeNemaL115p,
+	models.WritablePowerPortTypeNemaL515p,
+	models.WritablePowerPortTypeNemaL520p,
+	models.WritablePowerPortTypeNemaL530p,
+	models.WritablePowerPortTypeNemaL550p,
+	models.WritablePowerPortTypeNemaL615p,
+	models.WritablePowerPortTypeNemaL620p,
+	models.WritablePowerPortTypeNemaL630p,
+	models.WritablePowerPortTypeNemaL650p,
+	models.WritablePowerPortTypeNemaL1030p,
+	models.WritablePowerPortTypeNemaL1420p,
+	models.WritablePowerPortTypeNemaL1430p,
+	models.WritablePowerPortTypeNemaL1450p,
+	models.WritablePowerPortTypeNemaL1460p,
+	models.WritablePowerPortTypeNemaL1520p,
+	models.WritablePowerPortTypeNemaL1530p,
+	models.WritablePowerPortTypeNemaL1550p,
+	models.WritablePowerPortTypeNemaL1560p,
+	models.WritablePowerPortTypeNemaL2120p,
+	models.WritablePowerPortTypeNemaL2130p,
+	models.WritablePowerPortTypeCs6361c,
+	models.WritablePowerPortTypeCs6365c,
+	models.WritablePowerPortTypeCs8165c,
+	models.WritablePowerPortTypeCs8265c,
+	models.WritablePowerPortTypeCs8365c,
+	models.WritablePowerPortTypeCs8465c,
+	models.WritablePowerPortTypeItae,
+	models.WritablePowerPortTypeItaf,
+	models.WritablePowerPortTypeItaEf,
+	models.WritablePowerPortTypeItag,
+	models.WritablePowerPortTypeItah,
+	models.WritablePowerPortTypeItai,
+	models.WritablePowerPortTypeItaj,
+	models.WritablePowerPortTypeItak,
+	models.WritablePowerPortTypeItal,
+	models.WritablePowerPortTypeItam,
+	models.WritablePowerPortTypeItan,
+	models.WritablePowerPortTypeItao,
+}
+
+func resourceDcimPowerPort() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimPowerPortCreate,
+		ReadContext:   resourceDcimPowerPortRead,
+		UpdateContext: resourceDcimPowerPortUpdate,
+		DeleteContext: resourceDcimPowerPortDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resolveDeviceComponent("/dcim/power-ports/", "power port")),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 64),
+			},
+
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringInSlice(dcimPowerPortTypes),
+			},
+
+			"label": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 64),
+			},
+
+			"maximum_draw": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intAtLeast(1),
+			},
+
+			"allocated_draw": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intAtLeast(1),
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimPowerPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result dcimPowerPort
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/power-ports/", resourceDcimPowerPortData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create power port", err, resourceDcimPowerPort().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimPowerPortRead(ctx, d, m)
+}
+
+func resourceDcimPowerPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result dcimPowerPort
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/power-ports/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get power port", err, nil)
+	}
+
+	d.Set("device_id", result.Device.ID)
+	d.Set("name", result.Name)
+
+	if result.Type != nil {
+		d.Set("type", result.Type.Value)
+	} else {
+		d.Set("type", "")
+	}
+
+	d.Set("label", result.Label)
+	d.Set("maximum_draw", result.MaximumDraw)
+	d.Set("allocated_draw", result.AllocatedDraw)
+	d.Set("description", result.Description)
+	d.Set("tags", flattenTags(result.Tags))
+
+	return diags
+}
+
+func resourceDcimPowerPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/power-ports/%d/", objectID), resourceDcimPowerPortData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update power port", err, resourceDcimPowerPort().Schema)
+	}
+
+	return resourceDcimPowerPortRead(ctx, d, m)
+}
+
+func resourceDcimPowerPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimPowerPortsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimPowerPortsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete power port", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimPowerPortData returns the request body for creating and updating
+// power ports.
+func resourceDcimPowerPortData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"label":       d.Get("label").(string),
+		"description": d.Get("description").(string),
+		"tags":        requestTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("maximum_draw"); ok {
+		data["maximum_draw"] = v.(int)
+	} else {
+		data["maximum_draw"] = nil
+	}
+
+	if v, ok := d.GetOk("allocated_draw"); ok {
+		data["allocated_draw"] = v.(int)
+	} else {
+		data["allocated_draw"] = nil
+	}
+
+	return data
+}
@@ -0,0 +1,113 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestAccDcimPowerPort_basic(t *testing.T) {
+	name := "PSU0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimPowerPortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimPowerPortConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimPowerPortExists("netbox_dcim_power_port.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_power_port.test", "device_id", "netbox_dcim_device.test-power-port", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_port.test", "type", "iec-60320-c14"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_port.test", "maximum_draw", "350"),
+					resource.TestCheckResourceAttr("netbox_dcim_power_port.test", "allocated_draw", "200"),
+				),
+			},
+			{
+				Config: testAccCheckDcimPowerPortConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_power_port.test", "type", "iec-60320-c20"),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_power_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_power_port.test",
+				ImportState:       true,
+				ImportStateId:     "test-power-port/" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimPowerPortDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_power_port" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/power-ports/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Power port ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimPowerPortExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No power port ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/power-ports/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+func testAccCheckDcimPowerPortConfigBasic(name string) string {
+	return testAccDcimDeviceConfig("test-power-port") + fmt.Sprintf(`
+resource "netbox_dcim_power_port" "test" {
+  device_id      = netbox_dcim_device.test-power-port.id
+  name           = "%s"
+  type           = "iec-60320-c14"
+  maximum_draw   = 350
+  allocated_draw = 200
+}
+`, name)
+}
+
+func testAccCheckDcimPowerPortConfigUpdate(name string) string {
+	return testAccDcimDeviceConfig("test-power-port") + fmt.Sprintf(`
+resource "netbox_dcim_power_port" "test" {
+  device_id = netbox_dcim_device.test-power-port.id
+  name      = "%s"
+  type      = "iec-60320-c20"
+}
+`, name)
+}
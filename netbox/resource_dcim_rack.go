@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/dcim"
 
@@ -21,6 +21,10 @@ func resourceDcimRack() *schema.Resource {
 		UpdateContext: resourceDcimRackUpdate,
 		DeleteContext: resourceDcimRackDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -39,6 +43,11 @@ func resourceDcimRack() *schema.Resource {
 				Required: true,
 			},
 
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
 			"tenant_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -193,6 +202,11 @@ func resourceDcimRackCreate(ctx context.Context, d *schema.ResourceData, m inter
 		params.Data.Site = &siteID
 	}
 
+	if v, ok := d.GetOk("group_id"); ok {
+		groupID := int64(v.(int))
+		params.Data.Group = &groupID
+	}
+
 	if v, ok := d.GetOk("tenant_id"); ok {
 		tenantID := int64(v.(int))
 		params.Data.Tenant = &tenantID
@@ -256,7 +270,7 @@ func resourceDcimRackCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	resp, err := c.Dcim.DcimRacksCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create rack: %v", err)
+		return apiErrorDiags("Unable to create rack", err, resourceDcimRack().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -283,12 +297,12 @@ func resourceDcimRackRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	resp, err := c.Dcim.DcimRacksRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get rack: %v", err)
+		return apiErrorDiags("Unable to get rack", err, nil)
 	}
 
 	d.Set("name", resp.Payload.Name)
@@ -300,6 +314,12 @@ func resourceDcimRackRead(ctx context.Context, d *schema.ResourceData, m interfa
 		d.Set("facility", resp.Payload.FacilityID)
 	}
 
+	if resp.Payload.Group != nil {
+		d.Set("group_id", resp.Payload.Group.ID)
+	} else {
+		d.Set("group_id", nil)
+	}
+
 	if resp.Payload.Tenant != nil {
 		d.Set("tenant_id", resp.Payload.Tenant.ID)
 	}
@@ -345,7 +365,7 @@ func resourceDcimRackRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
 
 	return diags
 }
@@ -381,6 +401,16 @@ func resourceDcimRackUpdate(ctx context.Context, d *schema.ResourceData, m inter
 		params.Data.Site = &siteID
 	}
 
+	cleared := map[string]interface{}{}
+
+	if d.HasChange("group_id") {
+		if v := int64(d.Get("group_id").(int)); v != 0 {
+			params.Data.Group = &v
+		} else {
+			cleared["group"] = nil
+		}
+	}
+
 	if d.HasChange("tenant_id") {
 		tenantID := int64(d.Get("tenant_id").(int))
 		params.Data.Tenant = &tenantID
@@ -449,7 +479,16 @@ func resourceDcimRackUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	_, err = c.Dcim.DcimRacksPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update rack: %v", err)
+		return apiErrorDiags("Unable to update rack", err, resourceDcimRack().Schema)
+	}
+
+	// WritableRack drops a nil group, so removing the rack from its group
+	// needs a request of its own.
+	if len(cleared) > 0 {
+		err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/racks/%d/", objectID), cleared, nil)
+		if err != nil {
+			return apiErrorDiags("Unable to update rack", err, resourceDcimRack().Schema)
+		}
 	}
 
 	return resourceDcimRackRead(ctx, d, m)
@@ -471,8 +510,8 @@ func resourceDcimRackDelete(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	_, err = c.Dcim.DcimRacksDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete rack: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete rack", err, nil)
 	}
 
 	d.SetId("")
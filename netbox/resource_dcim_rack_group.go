@@ -0,0 +1,194 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDcimRackGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimRackGroupCreate,
+		ReadContext:   resourceDcimRackGroupRead,
+		UpdateContext: resourceDcimRackGroupUpdate,
+		DeleteContext: resourceDcimRackGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceDcimRackGroupResolveSlug),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 50),
+			},
+
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"site_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"parent_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+		},
+	}
+}
+
+func resourceDcimRackGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result models.RackGroup
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/rack-groups/", resourceDcimRackGroupData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create rack group", err, resourceDcimRackGroup().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimRackGroupRead(ctx, d, m)
+}
+
+func resourceDcimRackGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimRackGroupsReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Dcim.DcimRackGroupsRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get rack group", err, nil)
+	}
+
+	d.Set("name", resp.Payload.Name)
+	d.Set("slug", resp.Payload.Slug)
+	d.Set("site_id", resp.Payload.Site.ID)
+
+	if resp.Payload.Parent != nil {
+		d.Set("parent_id", resp.Payload.Parent.ID)
+	} else {
+		d.Set("parent_id", nil)
+	}
+
+	d.Set("description", resp.Payload.Description)
+
+	return diags
+}
+
+func resourceDcimRackGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/rack-groups/%d/", objectID), resourceDcimRackGroupData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update rack group", err, resourceDcimRackGroup().Schema)
+	}
+
+	return resourceDcimRackGroupRead(ctx, d, m)
+}
+
+func resourceDcimRackGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimRackGroupsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimRackGroupsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete rack group", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimRackGroupData returns the request body for creating and updating
+// rack groups.
+func resourceDcimRackGroupData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"site":        d.Get("site_id").(int),
+		"description": d.Get("description").(string),
+	}
+
+	if v, ok := d.GetOk("parent_id"); ok {
+		data["parent"] = v.(int)
+	} else {
+		data["parent"] = nil
+	}
+
+	return data
+}
+
+// resourceDcimRackGroupResolveSlug resolves a rack group slug. Slugs are only
+// unique within a site, so a slug used at several sites is ambiguous and the
+// group has to be imported by ID.
+func resourceDcimRackGroupResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &dcim.DcimRackGroupsListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Dcim.DcimRackGroupsList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list rack groups: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("rack group", slug, ids)
+}
@@ -0,0 +1,152 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func TestAccDcimRackGroup_basic(t *testing.T) {
+	name := "test rack group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimRackGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimRackGroupConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimRackGroupExists("netbox_dcim_rack_group.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_rack_group.test", "site_id", "netbox_dcim_site.test-rack-group", "id"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_rack_group.test", "parent_id", "netbox_dcim_rack_group.test-rack-group", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_rack_group.test", "description", "Acceptance test"),
+				),
+			},
+			{
+				Config: testAccCheckDcimRackGroupConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_rack_group.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_rack_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_rack_group.test",
+				ImportState:       true,
+				ImportStateId:     "test-rack-group",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimRackGroupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_rack_group" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimRackGroupsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Dcim.DcimRackGroupsRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Rack group ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimRackGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No rack group ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimRackGroupsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Dcim.DcimRackGroupsRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDcimRackGroupConfigBasic(name string) string {
+	return testAccDcimRackGroupSite + fmt.Sprintf(`
+resource "netbox_dcim_rack_group" "test" {
+  name        = "%s"
+  slug        = "test-rack-group"
+  site_id     = netbox_dcim_site.test-rack-group.id
+  parent_id   = netbox_dcim_rack_group.test-rack-group.id
+  description = "Acceptance test"
+}
+`, name)
+}
+
+func testAccCheckDcimRackGroupConfigUpdate(name string) string {
+	return testAccDcimRackGroupSite + fmt.Sprintf(`
+resource "netbox_dcim_rack_group" "test" {
+  name    = "%s"
+  slug    = "test-rack-group"
+  site_id = netbox_dcim_site.test-rack-group.id
+}
+`, name)
+}
+
+var testAccDcimRackGroupSite = `
+resource "netbox_dcim_site" "test-rack-group" {
+  name = "test-rack-group"
+  slug = "test-rack-group"
+}
+
+resource "netbox_dcim_rack_group" "test-rack-group" {
+  name    = "test-rack-group parent"
+  slug    = "test-rack-group-parent"
+  site_id = netbox_dcim_site.test-rack-group.id
+}
+`
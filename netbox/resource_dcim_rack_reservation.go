@@ -0,0 +1,289 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDcimRackReservation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimRackReservationCreate,
+		ReadContext:   resourceDcimRackReservationRead,
+		UpdateContext: resourceDcimRackReservationUpdate,
+		DeleteContext: resourceDcimRackReservationDelete,
+
+		CustomizeDiff: resourceDcimRackReservationCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rack_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"units": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeInt,
+					ValidateDiagFunc: intAtLeast(1),
+				},
+			},
+
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceDcimRackReservationCustomizeDiff checks the units against the rack
+// once both are known, so that a reservation outside the rack fails at plan
+// time rather than halfway through an apply.
+func resourceDcimRackReservationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("rack_id") || !d.NewValueKnown("units") {
+		return nil
+	}
+
+	if !d.HasChange("rack_id") && !d.HasChange("units") {
+		return nil
+	}
+
+	c := m.(*client.NetBoxAPI)
+
+	rackID := int64(d.Get("rack_id").(int))
+
+	params := &dcim.DcimRacksReadParams{
+		Context: ctx,
+		ID:      rackID,
+	}
+
+	resp, err := c.Dcim.DcimRacksRead(params, nil)
+	if err != nil {
+		return fmt.Errorf("Unable to get rack %d: %s", rackID, errorDetail(err))
+	}
+
+	units := []int{}
+	for _, v := range d.Get("units").(*schema.Set).List() {
+		units = append(units, v.(int))
+	}
+
+	return resourceDcimRackReservationCheckUnits(rackID, units, int(resp.Payload.UHeight), resp.Payload.DescUnits)
+}
+
+// resourceDcimRackReservationCheckUnits reports the units that don't exist in
+// a rack of the given height. NetBox numbers units from 1 at the bottom, or
+// from 1 at the top when desc_units is set, and the error says which.
+func resourceDcimRackReservationCheckUnits(rackID int64, units []int, uHeight int, descUnits bool) error {
+	invalid := []int{}
+	for _, u := range units {
+		if u < 1 || u > uHeight {
+			invalid = append(invalid, u)
+		}
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	sort.Ints(invalid)
+
+	list := make([]string, 0, len(invalid))
+	for _, u := range invalid {
+		list = append(list, strconv.Itoa(u))
+	}
+
+	from := "bottom"
+	if descUnits {
+		from = "top"
+	}
+
+	return fmt.Errorf("units %s don't exist in rack %d, which numbers its %d units from 1 at the %s",
+		strings.Join(list, ", "), rackID, uHeight, from)
+}
+
+func resourceDcimRackReservationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result models.RackReservation
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/rack-reservations/", resourceDcimRackReservationData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create rack reservation", err, resourceDcimRackReservation().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimRackReservationRead(ctx, d, m)
+}
+
+func resourceDcimRackReservationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimRackReservationsReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Dcim.DcimRackReservationsRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get rack reservation", err, nil)
+	}
+
+	units := make([]interface{}, 0, len(resp.Payload.Units))
+	for _, u := range resp.Payload.Units {
+		if u != nil {
+			units = append(units, int(*u))
+		}
+	}
+
+	d.Set("rack_id", resp.Payload.Rack.ID)
+	d.Set("units", units)
+	d.Set("user_id", resp.Payload.User.ID)
+
+	if resp.Payload.Tenant != nil {
+		d.Set("tenant_id", resp.Payload.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	d.Set("description", resp.Payload.Description)
+	d.Set("tags", flattenTags(resp.Payload.Tags))
+
+	return diags
+}
+
+func resourceDcimRackReservationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/rack-reservations/%d/", objectID), resourceDcimRackReservationData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update rack reservation", err, resourceDcimRackReservation().Schema)
+	}
+
+	return resourceDcimRackReservationRead(ctx, d, m)
+}
+
+func resourceDcimRackReservationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimRackReservationsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimRackReservationsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete rack reservation", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimRackReservationData returns the request body for creating and
+// updating rack reservations.
+func resourceDcimRackReservationData(d *schema.ResourceData) map[string]interface{} {
+	units := []int{}
+	for _, v := range d.Get("units").(*schema.Set).List() {
+		units = append(units, v.(int))
+	}
+
+	sort.Ints(units)
+
+	data := map[string]interface{}{
+		"rack":        d.Get("rack_id").(int),
+		"units":       units,
+		"user":        d.Get("user_id").(int),
+		"description": d.Get("description").(string),
+		"tags":        requestTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = v.(int)
+	} else {
+		data["tenant"] = nil
+	}
+
+	return data
+}
@@ -0,0 +1,190 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func TestResourceDcimRackReservationCheckUnits(t *testing.T) {
+	cases := []struct {
+		name      string
+		units     []int
+		descUnits bool
+		err       string
+	}{
+		{"inside", []int{1, 2, 10}, false, ""},
+		{"above", []int{11, 9, 12}, false, "units 11, 12 don't exist in rack 5, which numbers its 10 units from 1 at the bottom"},
+		{"zero", []int{0}, false, "units 0 don't exist"},
+		{"descending", []int{11}, true, "from 1 at the top"},
+	}
+
+	for _, tc := range cases {
+		err := resourceDcimRackReservationCheckUnits(5, tc.units, 10, tc.descUnits)
+
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+			t.Errorf("%s: expected an error containing %q, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+func TestAccDcimRackReservation_basic(t *testing.T) {
+	name := "test rack reservation"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimRackReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimRackReservationConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimRackReservationExists("netbox_dcim_rack_reservation.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_rack_reservation.test", "rack_id", "netbox_dcim_rack.test-rack-reservation", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_rack_reservation.test", "units.#", "3"),
+					resource.TestCheckResourceAttr("netbox_dcim_rack_reservation.test", "user_id", "1"),
+				),
+			},
+			{
+				Config: testAccCheckDcimRackReservationConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_rack_reservation.test", "units.#", "2"),
+					resource.TestCheckResourceAttr("netbox_dcim_rack_reservation.test", "description", "test rack reservation moved"),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_rack_reservation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccCheckDcimRackReservationConfigOutside(name),
+				ExpectError: regexp.MustCompile("units 11 don't exist"),
+			},
+		},
+	})
+}
+
+func testAccCheckDcimRackReservationDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_rack_reservation" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimRackReservationsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Dcim.DcimRackReservationsRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Rack reservation ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimRackReservationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No rack reservation ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimRackReservationsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Dcim.DcimRackReservationsRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDcimRackReservationConfigBasic(name string) string {
+	return testAccDcimRackReservationRack + fmt.Sprintf(`
+resource "netbox_dcim_rack_reservation" "test" {
+  rack_id     = netbox_dcim_rack.test-rack-reservation.id
+  units       = [1, 2, 3]
+  user_id     = 1
+  description = "%s"
+}
+`, name)
+}
+
+func testAccCheckDcimRackReservationConfigUpdate(name string) string {
+	return testAccDcimRackReservationRack + fmt.Sprintf(`
+resource "netbox_dcim_rack_reservation" "test" {
+  rack_id     = netbox_dcim_rack.test-rack-reservation.id
+  units       = [9, 10]
+  user_id     = 1
+  description = "%s moved"
+}
+`, name)
+}
+
+func testAccCheckDcimRackReservationConfigOutside(name string) string {
+	return testAccDcimRackReservationRack + fmt.Sprintf(`
+resource "netbox_dcim_rack_reservation" "test" {
+  rack_id     = netbox_dcim_rack.test-rack-reservation.id
+  units       = [10, 11]
+  user_id     = 1
+  description = "%s"
+}
+`, name)
+}
+
+var testAccDcimRackReservationRack = `
+resource "netbox_dcim_site" "test-rack-reservation" {
+  name = "test-rack-reservation"
+  slug = "test-rack-reservation"
+}
+
+resource "netbox_dcim_rack" "test-rack-reservation" {
+  name       = "test-rack-reservation"
+  site_id    = netbox_dcim_site.test-rack-reservation.id
+  u_height   = 10
+  desc_units = true
+}
+`
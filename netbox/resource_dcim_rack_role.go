@@ -0,0 +1,217 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dcimRackRole decodes rack roles returned by NetBox, including the tags and
+// custom fields the go-netbox model lacks.
+type dcimRackRole struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+	netboxExtras
+}
+
+func resourceDcimRackRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimRackRoleCreate,
+		ReadContext:   resourceDcimRackRoleRead,
+		UpdateContext: resourceDcimRackRoleUpdate,
+		DeleteContext: resourceDcimRackRoleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceDcimRackRoleResolveSlug),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 50),
+			},
+
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"color": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: isHexColor,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimRackRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result dcimRackRole
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/rack-roles/", resourceDcimRackRoleData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create rack role", err, resourceDcimRackRole().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimRackRoleRead(ctx, d, m)
+}
+
+func resourceDcimRackRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result dcimRackRole
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/rack-roles/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get rack role", err, nil)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	d.Set("color", result.Color)
+	d.Set("description", result.Description)
+
+	return setExtras(d, "rack roles", result.netboxExtras)
+}
+
+func resourceDcimRackRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/rack-roles/%d/", objectID), resourceDcimRackRoleData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update rack role", err, resourceDcimRackRole().Schema)
+	}
+
+	return resourceDcimRackRoleRead(ctx, d, m)
+}
+
+func resourceDcimRackRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/dcim/rack-roles/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete rack role", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimRackRoleData returns the request body for creating and
+// updating rack roles.
+func resourceDcimRackRoleData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"color":       d.Get("color").(string),
+		"description": d.Get("description").(string),
+	}
+
+	if d.HasChange("tags") {
+		data["tags"] = requestTags(d.Get("tags").([]interface{}))
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
+
+func resourceDcimRackRoleResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &dcim.DcimRackRolesListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Dcim.DcimRackRolesList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list rack roles: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("rack role", slug, ids)
+}
@@ -0,0 +1,138 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func TestAccDcimRackRole_basic(t *testing.T) {
+	name := "test rack role"
+	slug := "test-rack-role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimRackRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimRackRoleConfigBasic(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimRackRoleExists("netbox_dcim_rack_role.test"),
+					resource.TestCheckResourceAttr("netbox_dcim_rack_role.test", "color", "ff0000"),
+				),
+			},
+			{
+				Config: testAccCheckDcimRackRoleConfigUpdate(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_rack_role.test", "color", "00ff00"),
+					resource.TestCheckResourceAttr("netbox_dcim_rack_role.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_rack_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_rack_role.test",
+				ImportState:       true,
+				ImportStateId:     slug,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimRackRoleDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_rack_role" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimRackRolesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Dcim.DcimRackRolesRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Rack role ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimRackRoleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No rack role ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimRackRolesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Dcim.DcimRackRolesRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDcimRackRoleConfigBasic(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_rack_role" "test" {
+  name        = "%s"
+  slug        = "%s"
+  color       = "ff0000"
+  description = "Acceptance test"
+}
+`, name, slug)
+}
+
+func testAccCheckDcimRackRoleConfigUpdate(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_dcim_rack_role" "test" {
+  name  = "%s"
+  slug  = "%s"
+  color = "00ff00"
+}
+`, name, slug)
+}
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -28,6 +27,11 @@ func TestAccDcimRack_basic(t *testing.T) {
 					testAccCheckDcimRackExists("netbox_dcim_rack.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_dcim_rack.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -52,7 +56,7 @@ func testAccCheckDcimRackDestroy(s *terraform.State) error {
 
 		resp, err := c.Dcim.DcimRacksRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
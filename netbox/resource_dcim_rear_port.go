@@ -0,0 +1,232 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dcimPortTypes lists the connector types accepted by front and rear ports.
+var dcimPortTypes = []string{
+	models.WritableRearPortTypeNr8p8c,
+	models.WritableRearPortTypeNr8p6c,
+	models.WritableRearPortTypeNr8p4c,
+	models.WritableRearPortTypeNr8p2c,
+	models.WritableRearPortTypeNr110Punch,
+	models.WritableRearPortTypeBnc,
+	models.WritableRearPortTypeMrj21,
+	models.WritableRearPortTypeFc,
+	models.WritableRearPortTypeLc,
+	models.WritableRearPortTypeLcApc,
+	models.WritableRearPortTypeLsh,
+	models.WritableRearPortTypeLshApc,
+	models.WritableRearPortTypeMpo,
+	models.WritableRearPortTypeMtrj,
+	models.WritableRearPortTypeSc,
+	models.WritableRearPortTypeScApc,
+	models.WritableRearPortTypeSt,
+}
+
+func resourceDcimRearPort() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimRearPortCreate,
+		ReadContext:   resourceDcimRearPortRead,
+		UpdateContext: resourceDcimRearPortUpdate,
+		DeleteContext: resourceDcimRearPortDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resolveDeviceComponent("/dcim/rear-ports/", "rear port")),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 64),
+			},
+
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringInSlice(dcimPortTypes),
+			},
+
+			"label": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 64),
+			},
+
+			"positions": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateDiagFunc: intBetween(1, 64),
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDcimRearPortCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result models.RearPort
+
+	err := netboxRequest(ctx, c, "POST", "/dcim/rear-ports/", resourceDcimRearPortData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create rear port", err, resourceDcimRearPort().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceDcimRearPortRead(ctx, d, m)
+}
+
+func resourceDcimRearPortRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimRearPortsReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Dcim.DcimRearPortsRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get rear port", err, nil)
+	}
+
+	result := resp.Payload
+
+	d.Set("device_id", result.Device.ID)
+	d.Set("name", result.Name)
+
+	if result.Type != nil {
+		d.Set("type", result.Type.Value)
+	} else {
+		d.Set("type", "")
+	}
+
+	d.Set("label", result.Label)
+	d.Set("positions", result.Positions)
+	d.Set("description", result.Description)
+	d.Set("tags", flattenTags(result.Tags))
+
+	return diags
+}
+
+func resourceDcimRearPortUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/rear-ports/%d/", objectID), resourceDcimRearPortData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update rear port", err, resourceDcimRearPort().Schema)
+	}
+
+	return resourceDcimRearPortRead(ctx, d, m)
+}
+
+func resourceDcimRearPortDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimRearPortsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Dcim.DcimRearPortsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete rear port", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimRearPortData returns the request body for creating and updating
+// rear ports.
+func resourceDcimRearPortData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"device":      d.Get("device_id").(int),
+		"name":        d.Get("name").(string),
+		"type":        d.Get("type").(string),
+		"label":       d.Get("label").(string),
+		"description": d.Get("description").(string),
+		"tags":        requestTags(d.Get("tags").([]interface{})),
+	}
+
+	data["positions"] = d.Get("positions").(int)
+
+	return data
+}
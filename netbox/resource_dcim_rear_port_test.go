@@ -0,0 +1,113 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestAccDcimRearPort_basic(t *testing.T) {
+	name := "rear1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimRearPortDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimRearPortConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimRearPortExists("netbox_dcim_rear_port.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_rear_port.test", "device_id", "netbox_dcim_device.test-rear-port", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_rear_port.test", "type", "lc"),
+					resource.TestCheckResourceAttr("netbox_dcim_rear_port.test", "positions", "12"),
+				),
+			},
+			{
+				Config: testAccCheckDcimRearPortConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_rear_port.test", "type", "mpo"),
+					resource.TestCheckResourceAttr("netbox_dcim_rear_port.test", "positions", "24"),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_rear_port.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_rear_port.test",
+				ImportState:       true,
+				ImportStateId:     "test-rear-port/" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimRearPortDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_rear_port" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/rear-ports/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Rear port ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimRearPortExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No rear port ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/dcim/rear-ports/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+func testAccCheckDcimRearPortConfigBasic(name string) string {
+	return testAccDcimDeviceConfig("test-rear-port") + fmt.Sprintf(`
+resource "netbox_dcim_rear_port" "test" {
+  device_id = netbox_dcim_device.test-rear-port.id
+  name      = "%s"
+  type      = "lc"
+  positions = 12
+}
+`, name)
+}
+
+func testAccCheckDcimRearPortConfigUpdate(name string) string {
+	return testAccDcimDeviceConfig("test-rear-port") + fmt.Sprintf(`
+resource "netbox_dcim_rear_port" "test" {
+  device_id = netbox_dcim_device.test-rear-port.id
+  name      = "%s"
+  type      = "mpo"
+  positions = 24
+}
+`, name)
+}
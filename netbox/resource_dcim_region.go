@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/dcim"
 
@@ -21,6 +21,10 @@ func resourceDcimRegion() *schema.Resource {
 		UpdateContext: resourceDcimRegionUpdate,
 		DeleteContext: resourceDcimRegionDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceDcimRegionResolveSlug),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -75,7 +79,7 @@ func resourceDcimRegionCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	resp, err := c.Dcim.DcimRegionsCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create region: %v", err)
+		return apiErrorDiags("Unable to create region", err, resourceDcimRegion().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -102,12 +106,12 @@ func resourceDcimRegionRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	resp, err := c.Dcim.DcimRegionsRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get region: %v", err)
+		return apiErrorDiags("Unable to get region", err, nil)
 	}
 
 	d.Set("name", resp.Payload.Name)
@@ -156,7 +160,7 @@ func resourceDcimRegionUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 	_, err = c.Dcim.DcimRegionsPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update region: %v", err)
+		return apiErrorDiags("Unable to update region", err, resourceDcimRegion().Schema)
 	}
 
 	return resourceDcimRegionRead(ctx, d, m)
@@ -178,11 +182,30 @@ func resourceDcimRegionDelete(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	_, err = c.Dcim.DcimRegionsDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete region: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete region", err, nil)
 	}
 
 	d.SetId("")
 
 	return diags
 }
+
+func resourceDcimRegionResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &dcim.DcimRegionsListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Dcim.DcimRegionsList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list regions: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("region", slug, ids)
+}
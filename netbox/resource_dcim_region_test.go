@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -28,6 +27,17 @@ func TestAccDcimRegion_basic(t *testing.T) {
 					testAccCheckDcimRegionExists("netbox_dcim_region.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_dcim_region.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_region.test",
+				ImportState:       true,
+				ImportStateId:     slug,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -52,7 +62,7 @@ func testAccCheckDcimRegionDestroy(s *terraform.State) error {
 
 		resp, err := c.Dcim.DcimRegionsRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
@@ -100,7 +110,7 @@ func testAccCheckDcimRegionExists(n string) resource.TestCheckFunc {
 
 func testAccCheckDcimRegionConfigBasic(name string, slug string) string {
 	return fmt.Sprintf(`
-	resource "netbox_dcim_region" "example" {
+	resource "netbox_dcim_region" "test" {
 		name = "%s"
 		slug = "%s"
 	  }
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/dcim"
@@ -22,6 +22,10 @@ func resourceDcimSite() *schema.Resource {
 		UpdateContext: resourceDcimSiteUpdate,
 		DeleteContext: resourceDcimSiteDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceDcimSiteResolveSlug),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:             schema.TypeString,
@@ -246,7 +250,7 @@ func resourceDcimSiteCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	resp, err := c.Dcim.DcimSitesCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create site: %v", err)
+		return apiErrorDiags("Unable to create site", err, resourceDcimSite().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -273,12 +277,12 @@ func resourceDcimSiteRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	resp, err := c.Dcim.DcimSitesRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get site: %v", err)
+		return apiErrorDiags("Unable to get site", err, nil)
 	}
 
 	d.Set("name", resp.Payload.Name)
@@ -345,7 +349,7 @@ func resourceDcimSiteRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 
 	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
 
 	return diags
 }
@@ -447,7 +451,7 @@ func resourceDcimSiteUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	_, err = c.Dcim.DcimSitesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update site: %v", err)
+		return apiErrorDiags("Unable to update site", err, resourceDcimSite().Schema)
 	}
 
 	return resourceDcimSiteRead(ctx, d, m)
@@ -469,11 +473,30 @@ func resourceDcimSiteDelete(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	_, err = c.Dcim.DcimSitesDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete site: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete site", err, nil)
 	}
 
 	d.SetId("")
 
 	return diags
 }
+
+func resourceDcimSiteResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &dcim.DcimSitesListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Dcim.DcimSitesList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list sites: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("site", slug, ids)
+}
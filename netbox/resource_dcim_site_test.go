@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -28,6 +27,17 @@ func TestAccDcimSite_basic(t *testing.T) {
 					testAccCheckDcimSiteExists("netbox_dcim_site.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_dcim_site.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_dcim_site.test",
+				ImportState:       true,
+				ImportStateId:     slug,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -52,7 +62,7 @@ func testAccCheckDcimSiteDestroy(s *terraform.State) error {
 
 		resp, err := c.Dcim.DcimSitesRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
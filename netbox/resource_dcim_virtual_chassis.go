@@ -0,0 +1,435 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDcimVirtualChassis() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcimVirtualChassisCreate,
+		ReadContext:   resourceDcimVirtualChassisRead,
+		UpdateContext: resourceDcimVirtualChassisUpdate,
+		DeleteContext: resourceDcimVirtualChassisDelete,
+		CustomizeDiff: resourceDcimVirtualChassisCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 64),
+			},
+
+			"domain": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 30),
+			},
+
+			"master_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"member": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"position": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateDiagFunc: intBetween(0, 255),
+						},
+						"priority": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: intBetween(0, 255),
+						},
+					},
+				},
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceDcimVirtualChassisCheckMembers reports a master that isn't one of
+// the members and positions used more than once. Members whose device ID
+// isn't known yet are zero and skipped.
+func resourceDcimVirtualChassisCheckMembers(masterID int, members []interface{}) error {
+	positions := make(map[int]int)
+	masterFound := masterID == 0
+
+	for _, v := range members {
+		member := v.(map[string]interface{})
+		deviceID := member["device_id"].(int)
+		position := member["position"].(int)
+
+		if deviceID == 0 {
+			masterFound = true
+			continue
+		}
+
+		if other, ok := positions[position]; ok {
+			return fmt.Errorf("devices %d and %d both use position %d", other, deviceID, position)
+		}
+
+		positions[position] = deviceID
+
+		if deviceID == masterID {
+			masterFound = true
+		}
+	}
+
+	if !masterFound {
+		return fmt.Errorf("master_id %d must be one of the member devices", masterID)
+	}
+
+	return nil
+}
+
+func resourceDcimVirtualChassisCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("master_id") || !d.NewValueKnown("member") {
+		return nil
+	}
+
+	return resourceDcimVirtualChassisCheckMembers(d.Get("master_id").(int), d.Get("member").(*schema.Set).List())
+}
+
+func resourceDcimVirtualChassisCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	masterID := d.Get("master_id").(int)
+	members := d.Get("member").(*schema.Set).List()
+
+	if err := resourceDcimVirtualChassisCheckMembers(masterID, members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	params := &dcim.DcimVirtualChassisCreateParams{
+		Context: ctx,
+	}
+
+	params.Data = &models.WritableVirtualChassis{
+		Name: &name,
+		Tags: expandTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("domain"); ok {
+		params.Data.Domain = v.(string)
+	}
+
+	resp, err := c.Dcim.DcimVirtualChassisCreate(params, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to create virtual chassis", err, resourceDcimVirtualChassis().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
+
+	// The master must be a member before it can be set, so members are
+	// assigned first.
+	for _, v := range members {
+		if diags := resourceDcimVirtualChassisAddMember(ctx, c, resp.Payload.ID, v.(map[string]interface{})); diags.HasError() {
+			return diags
+		}
+	}
+
+	if masterID != 0 {
+		if diags := resourceDcimVirtualChassisPatch(ctx, c, resp.Payload.ID, map[string]interface{}{"master": masterID}); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceDcimVirtualChassisRead(ctx, d, m)
+}
+
+func resourceDcimVirtualChassisRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimVirtualChassisReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Dcim.DcimVirtualChassisRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get virtual chassis", err, nil)
+	}
+
+	d.Set("name", resp.Payload.Name)
+	d.Set("domain", resp.Payload.Domain)
+
+	if resp.Payload.Master != nil {
+		d.Set("master_id", resp.Payload.Master.ID)
+	} else {
+		d.Set("master_id", nil)
+	}
+
+	d.Set("tags", flattenTags(resp.Payload.Tags))
+
+	var devices struct {
+		Results []struct {
+			ID         int64  `json:"id"`
+			VcPosition *int64 `json:"vc_position"`
+			VcPriority *int64 `json:"vc_priority"`
+		} `json:"results"`
+	}
+
+	// Members are listed through netboxRequest, as go-netbox fails to decode
+	// devices that config contexts with nested data apply to.
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/dcim/devices/?virtual_chassis_id=%d&limit=0", objectID), nil, &devices)
+	if err != nil {
+		return apiErrorDiags("Unable to get virtual chassis members", err, nil)
+	}
+
+	members := make([]interface{}, 0, len(devices.Results))
+	for _, v := range devices.Results {
+		member := map[string]interface{}{
+			"device_id": int(v.ID),
+		}
+
+		if v.VcPosition != nil {
+			member["position"] = int(*v.VcPosition)
+		}
+
+		if v.VcPriority != nil {
+			member["priority"] = int(*v.VcPriority)
+		}
+
+		members = append(members, member)
+	}
+
+	d.Set("member", members)
+
+	return diags
+}
+
+func resourceDcimVirtualChassisUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	masterID := d.Get("master_id").(int)
+
+	if err := resourceDcimVirtualChassisCheckMembers(masterID, d.Get("member").(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	params := &dcim.DcimVirtualChassisPartialUpdateParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	params.Data = &models.WritableVirtualChassis{
+		Name: &name,
+	}
+
+	cleared := map[string]interface{}{}
+
+	if d.HasChange("domain") {
+		if v := d.Get("domain").(string); v != "" {
+			params.Data.Domain = v
+		} else {
+			cleared["domain"] = ""
+		}
+	}
+
+	if d.HasChange("tags") {
+		params.Data.Tags = expandTags(d.Get("tags").([]interface{}))
+	}
+
+	_, err = c.Dcim.DcimVirtualChassisPartialUpdate(params, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update virtual chassis", err, resourceDcimVirtualChassis().Schema)
+	}
+
+	if len(cleared) > 0 {
+		if diags := resourceDcimVirtualChassisPatch(ctx, c, objectID, cleared); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChanges("master_id", "member") {
+		o, n := d.GetChange("member")
+		removed := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		oldMasterID, _ := d.GetChange("master_id")
+
+		// The master can't leave the chassis, so it is unset while members
+		// change and set again once they are in place.
+		resetMaster := d.HasChange("master_id")
+		for _, v := range removed {
+			if v.(map[string]interface{})["device_id"].(int) == oldMasterID.(int) {
+				resetMaster = true
+			}
+		}
+
+		if resetMaster && oldMasterID.(int) != 0 {
+			if diags := resourceDcimVirtualChassisPatch(ctx, c, objectID, map[string]interface{}{"master": nil}); diags.HasError() {
+				return diags
+			}
+		}
+
+		// Changed members are removed before they are added again, so
+		// that members can swap positions.
+		for _, v := range removed {
+			if diags := resourceDcimVirtualChassisRemoveMember(ctx, c, v.(map[string]interface{})); diags.HasError() {
+				return diags
+			}
+		}
+
+		for _, v := range added {
+			if diags := resourceDcimVirtualChassisAddMember(ctx, c, objectID, v.(map[string]interface{})); diags.HasError() {
+				return diags
+			}
+		}
+
+		if resetMaster && masterID != 0 {
+			if diags := resourceDcimVirtualChassisPatch(ctx, c, objectID, map[string]interface{}{"master": masterID}); diags.HasError() {
+				return diags
+			}
+		}
+	}
+
+	return resourceDcimVirtualChassisRead(ctx, d, m)
+}
+
+func resourceDcimVirtualChassisDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &dcim.DcimVirtualChassisDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	// NetBox releases the members when the chassis is deleted.
+	_, err = c.Dcim.DcimVirtualChassisDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete virtual chassis", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceDcimVirtualChassisPatch sends fields the go-netbox model can't
+// carry, such as a null master.
+func resourceDcimVirtualChassisPatch(ctx context.Context, c *client.NetBoxAPI, id int64, fields map[string]interface{}) diag.Diagnostics {
+	path := fmt.Sprintf("/dcim/virtual-chassis/%d/", id)
+
+	if err := netboxRequest(ctx, c, "PATCH", path, fields, nil); err != nil {
+		return apiErrorDiags("Unable to update virtual chassis", err, resourceDcimVirtualChassis().Schema)
+	}
+
+	return nil
+}
+
+func resourceDcimVirtualChassisAddMember(ctx context.Context, c *client.NetBoxAPI, vcID int64, member map[string]interface{}) diag.Diagnostics {
+	deviceID := member["device_id"].(int)
+
+	fields := map[string]interface{}{
+		"virtual_chassis": vcID,
+		"vc_position":     member["position"].(int),
+		"vc_priority":     nil,
+	}
+
+	if v := member["priority"].(int); v != 0 {
+		fields["vc_priority"] = v
+	}
+
+	if err := netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/devices/%d/", deviceID), fields, nil); err != nil {
+		return apiErrorDiags(fmt.Sprintf("Unable to add device %d to virtual chassis", deviceID), err, nil)
+	}
+
+	return nil
+}
+
+func resourceDcimVirtualChassisRemoveMember(ctx context.Context, c *client.NetBoxAPI, member map[string]interface{}) diag.Diagnostics {
+	deviceID := member["device_id"].(int)
+
+	fields := map[string]interface{}{
+		"virtual_chassis": nil,
+		"vc_position":     nil,
+		"vc_priority":     nil,
+	}
+
+	err := netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/dcim/devices/%d/", deviceID), fields, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags(fmt.Sprintf("Unable to remove device %d from virtual chassis", deviceID), err, nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/dcim"
+)
+
+func TestResourceDcimVirtualChassisCheckMembers(t *testing.T) {
+	member := func(deviceID, position int) interface{} {
+		return map[string]interface{}{"device_id": deviceID, "position": position, "priority": 0}
+	}
+
+	cases := []struct {
+		name     string
+		masterID int
+		members  []interface{}
+		err      string
+	}{
+		{"no master", 0, []interface{}{member(1, 1), member(2, 2)}, ""},
+		{"master is a member", 2, []interface{}{member(1, 1), member(2, 2)}, ""},
+		{"master is not a member", 3, []interface{}{member(1, 1), member(2, 2)}, "master_id 3"},
+		{"master without members", 3, nil, "master_id 3"},
+		{"unknown member", 3, []interface{}{member(1, 1), member(0, 2)}, ""},
+		{"duplicate position", 0, []interface{}{member(1, 1), member(2, 1)}, "position 1"},
+	}
+
+	for _, tc := range cases {
+		err := resourceDcimVirtualChassisCheckMembers(tc.masterID, tc.members)
+
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+			t.Errorf("%s: expected an error containing %q, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
+func TestAccDcimVirtualChassis_basic(t *testing.T) {
+	name := "test-virtual-chassis"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDcimVirtualChassisDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDcimVirtualChassisConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDcimVirtualChassisExists("netbox_dcim_virtual_chassis.test"),
+					resource.TestCheckResourceAttrPair("netbox_dcim_virtual_chassis.test", "master_id", "netbox_dcim_device.test-virtual-chassis-1", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_virtual_chassis.test", "member.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckDcimVirtualChassisConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("netbox_dcim_virtual_chassis.test", "master_id", "netbox_dcim_device.test-virtual-chassis-2", "id"),
+					resource.TestCheckResourceAttr("netbox_dcim_virtual_chassis.test", "domain", ""),
+					resource.TestCheckResourceAttr("netbox_dcim_virtual_chassis.test", "member.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "netbox_dcim_virtual_chassis.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDcimVirtualChassisDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_dcim_virtual_chassis" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimVirtualChassisReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Dcim.DcimVirtualChassisRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual chassis ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDcimVirtualChassisExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No virtual chassis ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &dcim.DcimVirtualChassisReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Dcim.DcimVirtualChassisRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+const testAccDcimVirtualChassisDevices = `
+resource "netbox_dcim_site" "test-virtual-chassis" {
+  name = "test-virtual-chassis"
+  slug = "test-virtual-chassis"
+}
+
+resource "netbox_dcim_manufacturer" "test-virtual-chassis" {
+  name = "test-virtual-chassis"
+  slug = "test-virtual-chassis"
+}
+
+resource "netbox_dcim_device_type" "test-virtual-chassis" {
+  manufacturer_id = netbox_dcim_manufacturer.test-virtual-chassis.id
+  model           = "test-virtual-chassis"
+  slug            = "test-virtual-chassis"
+}
+
+resource "netbox_dcim_device_role" "test-virtual-chassis" {
+  name  = "test-virtual-chassis"
+  slug  = "test-virtual-chassis"
+  color = "ff0000"
+}
+
+resource "netbox_dcim_device" "test-virtual-chassis-1" {
+  name           = "test-virtual-chassis-1"
+  device_type_id = netbox_dcim_device_type.test-virtual-chassis.id
+  device_role_id = netbox_dcim_device_role.test-virtual-chassis.id
+  site_id        = netbox_dcim_site.test-virtual-chassis.id
+}
+
+resource "netbox_dcim_device" "test-virtual-chassis-2" {
+  name           = "test-virtual-chassis-2"
+  device_type_id = netbox_dcim_device_type.test-virtual-chassis.id
+  device_role_id = netbox_dcim_device_role.test-virtual-chassis.id
+  site_id        = netbox_dcim_site.test-virtual-chassis.id
+}
+`
+
+func testAccCheckDcimVirtualChassisConfigBasic(name string) string {
+	return testAccDcimVirtualChassisDevices + fmt.Sprintf(`
+resource "netbox_dcim_virtual_chassis" "test" {
+  name      = "%s"
+  domain    = "test-domain"
+  master_id = netbox_dcim_device.test-virtual-chassis-1.id
+
+  member {
+    device_id = netbox_dcim_device.test-virtual-chassis-1.id
+    position  = 1
+    priority  = 255
+  }
+
+  member {
+    device_id = netbox_dcim_device.test-virtual-chassis-2.id
+    position  = 2
+  }
+}
+`, name)
+}
+
+func testAccCheckDcimVirtualChassisConfigUpdate(name string) string {
+	return testAccDcimVirtualChassisDevices + fmt.Sprintf(`
+resource "netbox_dcim_virtual_chassis" "test" {
+  name      = "%s"
+  master_id = netbox_dcim_device.test-virtual-chassis-2.id
+
+  member {
+    device_id = netbox_dcim_device.test-virtual-chassis-1.id
+    position  = 2
+  }
+
+  member {
+    device_id = netbox_dcim_device.test-virtual-chassis-2.id
+    position  = 1
+    priority  = 255
+  }
+}
+`, name)
+}
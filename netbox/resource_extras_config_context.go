@@ -0,0 +1,289 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// extrasConfigContext decodes config contexts returned by NetBox. go-netbox
+// models data as a string, while NetBox sends and expects a JSON object, so
+// config contexts are managed through netboxRequest.
+type extrasConfigContext struct {
+	ID            int64                       `json:"id"`
+	Name          string                      `json:"name"`
+	Weight        int64                       `json:"weight"`
+	Description   string                      `json:"description"`
+	IsActive      bool                        `json:"is_active"`
+	Regions       []extrasConfigContextObject `json:"regions"`
+	Sites         []extrasConfigContextObject `json:"sites"`
+	Roles         []extrasConfigContextObject `json:"roles"`
+	Platforms     []extrasConfigContextObject `json:"platforms"`
+	ClusterGroups []extrasConfigContextObject `json:"cluster_groups"`
+	Clusters      []extrasConfigContextObject `json:"clusters"`
+	TenantGroups  []extrasConfigContextObject `json:"tenant_groups"`
+	Tenants       []extrasConfigContextObject `json:"tenants"`
+	Tags          []string                    `json:"tags"`
+	Data          json.RawMessage             `json:"data"`
+}
+
+// extrasConfigContextObject decodes the objects a config context is assigned
+// to, of which only the ID is kept.
+type extrasConfigContextObject struct {
+	ID int64 `json:"id"`
+}
+
+// extrasConfigContextAssignments lists the attributes holding the IDs of the
+// objects a config context is assigned to, which are named after the fields
+// of the NetBox API.
+var extrasConfigContextAssignments = []string{
+	"regions",
+	"sites",
+	"roles",
+	"platforms",
+	"cluster_groups",
+	"clusters",
+	"tenant_groups",
+	"tenants",
+}
+
+// assignments returns the objects the config context is assigned to by
+// attribute.
+func (cc *extrasConfigContext) assignments() map[string][]extrasConfigContextObject {
+	return map[string][]extrasConfigContextObject{
+		"regions":        cc.Regions,
+		"sites":          cc.Sites,
+		"roles":          cc.Roles,
+		"platforms":      cc.Platforms,
+		"cluster_groups": cc.ClusterGroups,
+		"clusters":       cc.Clusters,
+		"tenant_groups":  cc.TenantGroups,
+		"tenants":        cc.Tenants,
+	}
+}
+
+func resourceExtrasConfigContext() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: stringLenBetween(1, 100),
+		},
+
+		"weight": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          1000,
+			ValidateDiagFunc: intBetween(0, 32767),
+		},
+
+		"description": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: stringLenBetween(0, 200),
+		},
+
+		"is_active": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"data": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: isJSONObject,
+			StateFunc:        jsonStateFunc,
+		},
+
+		"tags": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	for _, attr := range extrasConfigContextAssignments {
+		s[attr] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceExtrasConfigContextCreate,
+		ReadContext:   resourceExtrasConfigContextRead,
+		UpdateContext: resourceExtrasConfigContextUpdate,
+		DeleteContext: resourceExtrasConfigContextDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceExtrasConfigContextResolveName),
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceExtrasConfigContextCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result extrasConfigContext
+
+	err := netboxRequest(ctx, c, "POST", "/extras/config-contexts/", resourceExtrasConfigContextData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create config context", err, resourceExtrasConfigContext().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceExtrasConfigContextRead(ctx, d, m)
+}
+
+func resourceExtrasConfigContextRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result extrasConfigContext
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/extras/config-contexts/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get config context", err, nil)
+	}
+
+	data, err := normalizeJSON(string(result.Data))
+	if err != nil {
+		return diag.Errorf("Unable to parse data: %v", err)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("weight", result.Weight)
+	d.Set("description", result.Description)
+	d.Set("is_active", result.IsActive)
+	d.Set("data", data)
+
+	for attr, objects := range result.assignments() {
+		ids := make([]interface{}, 0, len(objects))
+		for _, v := range objects {
+			ids = append(ids, v.ID)
+		}
+
+		d.Set(attr, ids)
+	}
+
+	d.Set("tags", result.Tags)
+
+	return diags
+}
+
+func resourceExtrasConfigContextUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/extras/config-contexts/%d/", objectID), resourceExtrasConfigContextData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update config context", err, resourceExtrasConfigContext().Schema)
+	}
+
+	return resourceExtrasConfigContextRead(ctx, d, m)
+}
+
+func resourceExtrasConfigContextDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/extras/config-contexts/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete config context", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceExtrasConfigContextData returns the request body for creating and
+// updating config contexts. Assignments are always sent, so that emptying a
+// set removes the assignments from NetBox. Tags are assigned by slug.
+func resourceExtrasConfigContextData(d *schema.ResourceData) map[string]interface{} {
+	tags := make([]string, 0)
+	for _, v := range d.Get("tags").(*schema.Set).List() {
+		tags = append(tags, v.(string))
+	}
+
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"weight":      d.Get("weight").(int),
+		"description": d.Get("description").(string),
+		"is_active":   d.Get("is_active").(bool),
+		"data":        json.RawMessage(d.Get("data").(string)),
+		"tags":        tags,
+	}
+
+	for _, attr := range extrasConfigContextAssignments {
+		ids := make([]int64, 0)
+		for _, v := range d.Get(attr).(*schema.Set).List() {
+			ids = append(ids, int64(v.(int)))
+		}
+
+		data[attr] = ids
+	}
+
+	return data
+}
+
+// resourceExtrasConfigContextResolveName resolves a config context name,
+// which is unique in NetBox.
+func resourceExtrasConfigContextResolveName(ctx context.Context, c *client.NetBoxAPI, name string) (int64, error) {
+	var result struct {
+		Results []struct {
+			ID int64 `json:"id"`
+		} `json:"results"`
+	}
+
+	query := url.Values{"name": {name}}
+
+	err := netboxRequest(ctx, c, "GET", "/extras/config-contexts/?"+query.Encode(), nil, &result)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list config contexts: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(result.Results))
+	for _, v := range result.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("config context", name, ids)
+}
@@ -0,0 +1,182 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestResourceExtrasConfigContextCreate(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == "POST" {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+
+			if !reflect.DeepEqual(body["sites"], []interface{}{float64(3)}) {
+				t.Errorf("expected sites [3], got %#v", body["sites"])
+			}
+
+			if v, ok := body["tenants"]; !ok || !reflect.DeepEqual(v, []interface{}{}) {
+				t.Errorf("expected empty tenants, got %#v", v)
+			}
+
+			if !reflect.DeepEqual(body["data"], map[string]interface{}{"ntp": []interface{}{"10.0.0.1"}}) {
+				t.Errorf("expected data to be sent as a JSON object, got %#v", body["data"])
+			}
+		}
+
+		w.Write([]byte(`{
+			"id": 24,
+			"name": "ntp servers",
+			"weight": 1000,
+			"description": "",
+			"is_active": true,
+			"regions": [],
+			"sites": [{"id": 3, "name": "site"}],
+			"roles": [],
+			"platforms": [],
+			"cluster_groups": [],
+			"clusters": [],
+			"tenant_groups": [],
+			"tenants": [],
+			"tags": ["core"],
+			"data": {"ntp": ["10.0.0.1"]}
+		}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceExtrasConfigContext().Schema, map[string]interface{}{
+		"name":  "ntp servers",
+		"data":  `{ "ntp": [ "10.0.0.1" ] }`,
+		"sites": []interface{}{3},
+		"tags":  []interface{}{"core"},
+	})
+
+	if diags := resourceExtrasConfigContextCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "24" {
+		t.Fatalf("expected ID 24, got %s", d.Id())
+	}
+
+	if d.Get("data").(string) != `{"ntp":["10.0.0.1"]}` {
+		t.Fatalf("expected normalized data, got %q", d.Get("data"))
+	}
+
+	if sites := d.Get("sites").(*schema.Set); sites.Len() != 1 || !sites.Contains(3) {
+		t.Fatalf("unexpected sites %v", sites.List())
+	}
+}
+
+func TestAccExtrasConfigContext_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckExtrasConfigContextDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckExtrasConfigContextConfigBasic("10.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExtrasConfigContextExists("netbox_extras_config_context.test"),
+					resource.TestCheckResourceAttr("netbox_extras_config_context.test", "data", `{"ntp":{"servers":["10.0.0.1"]}}`),
+					resource.TestCheckResourceAttr("netbox_extras_config_context.test", "sites.#", "1"),
+					resource.TestCheckResourceAttr("netbox_extras_config_context.test", "tenants.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckExtrasConfigContextConfigBasic("10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_extras_config_context.test", "data", `{"ntp":{"servers":["10.0.0.2"]}}`),
+				),
+			},
+			{
+				Config: testAccCheckExtrasConfigContextConfigBasic("10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_dcim_device.test-config-context", "config_context", `{"ntp":{"servers":["10.0.0.2"]}}`),
+				),
+			},
+			{
+				ResourceName:      "netbox_extras_config_context.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_extras_config_context.test",
+				ImportState:       true,
+				ImportStateId:     "test-config-context",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckExtrasConfigContextDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_extras_config_context" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/extras/config-contexts/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Config context ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckExtrasConfigContextExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No config context ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/extras/config-contexts/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+// testAccCheckExtrasConfigContextConfigBasic assigns the config context to
+// the site of the device, which then exposes it in config_context. The
+// device is refreshed by the step following the change of the config context.
+func testAccCheckExtrasConfigContextConfigBasic(server string) string {
+	return testAccDcimDeviceConfig("test-config-context") + fmt.Sprintf(`
+resource "netbox_extras_config_context" "test" {
+  name  = "test-config-context"
+  sites = [netbox_dcim_site.test-config-context.id]
+
+  data = jsonencode({
+    ntp = {
+      servers = ["%s"]
+    }
+  })
+}
+`, server)
+}
@@ -0,0 +1,376 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// extrasCustomField decodes custom field definitions returned by NetBox.
+// Custom fields can be managed through the API since NetBox 2.10 and
+// go-netbox has no model or client for them, so they are managed entirely
+// through netboxRequest. The default value is any JSON document.
+type extrasCustomField struct {
+	ID           int64    `json:"id"`
+	ContentTypes []string `json:"content_types"`
+	Type         *struct {
+		Value string `json:"value"`
+	} `json:"type"`
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+	Required    bool   `json:"required"`
+	FilterLogic *struct {
+		Value string `json:"value"`
+	} `json:"filter_logic"`
+	Default           json.RawMessage `json:"default"`
+	Weight            int64           `json:"weight"`
+	ValidationMinimum *int64          `json:"validation_minimum"`
+	ValidationMaximum *int64          `json:"validation_maximum"`
+	ValidationRegex   string          `json:"validation_regex"`
+	Choices           []string        `json:"choices"`
+}
+
+// extrasCustomFieldSelectTypes are the custom field types whose values are
+// picked from choices.
+var extrasCustomFieldSelectTypes = map[string]bool{
+	"select":      true,
+	"multiselect": true,
+}
+
+func resourceExtrasCustomField() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceExtrasCustomFieldCreate,
+		ReadContext:   resourceExtrasCustomFieldRead,
+		UpdateContext: resourceExtrasCustomFieldUpdate,
+		DeleteContext: resourceExtrasCustomFieldDelete,
+
+		CustomizeDiff: resourceExtrasCustomFieldCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceExtrasCustomFieldResolveName),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 50),
+			},
+
+			"label": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 50),
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					"text",
+					"integer",
+					"boolean",
+					"date",
+					"url",
+					"json",
+					"select",
+					"multiselect",
+				}),
+
+				Default: "text",
+			},
+
+			"content_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 100),
+			},
+
+			"required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"default": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isJSON,
+				StateFunc:        jsonStateFunc,
+			},
+
+			"weight": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: intBetween(0, 32767),
+			},
+
+			"filter_logic": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					"disabled",
+					"loose",
+					"exact",
+				}),
+
+				Default: "loose",
+			},
+
+			"choices": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: stringLenBetween(1, 100),
+				},
+			},
+
+			"validation_minimum": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"validation_maximum": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"validation_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 500),
+			},
+		},
+	}
+}
+
+func resourceExtrasCustomFieldCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("choices") {
+		return nil
+	}
+
+	fieldType := d.Get("type").(string)
+	choices := d.Get("choices").([]interface{})
+
+	switch {
+	case extrasCustomFieldSelectTypes[fieldType] && len(choices) == 0:
+		return fmt.Errorf("choices must be set for custom fields of type %s", fieldType)
+	case !extrasCustomFieldSelectTypes[fieldType] && len(choices) != 0:
+		return fmt.Errorf("choices can only be set for custom fields of type select or multiselect, not %s", fieldType)
+	}
+
+	// GetOk would treat a bound of 0 as unset.
+	minimum, minOk := d.GetOkExists("validation_minimum")
+	maximum, maxOk := d.GetOkExists("validation_maximum")
+
+	if minOk && maxOk && minimum.(int) > maximum.(int) {
+		return fmt.Errorf("validation_minimum %d must not be above validation_maximum %d", minimum.(int), maximum.(int))
+	}
+
+	return nil
+}
+
+func resourceExtrasCustomFieldCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result extrasCustomField
+
+	err := netboxRequest(ctx, c, "POST", "/extras/custom-fields/", resourceExtrasCustomFieldData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create custom field", err, resourceExtrasCustomField().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceExtrasCustomFieldRead(ctx, d, m)
+}
+
+func resourceExtrasCustomFieldRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result extrasCustomField
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/extras/custom-fields/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get custom field", err, nil)
+	}
+
+	defaultValue, err := normalizeJSON(string(result.Default))
+	if err != nil {
+		return diag.Errorf("Unable to parse default: %v", err)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("label", result.Label)
+
+	if result.Type != nil {
+		d.Set("type", result.Type.Value)
+	}
+
+	d.Set("content_types", result.ContentTypes)
+	d.Set("description", result.Description)
+	d.Set("required", result.Required)
+	d.Set("default", defaultValue)
+	d.Set("weight", result.Weight)
+
+	if result.FilterLogic != nil {
+		d.Set("filter_logic", result.FilterLogic.Value)
+	}
+
+	d.Set("choices", result.Choices)
+
+	if result.ValidationMinimum != nil {
+		d.Set("validation_minimum", result.ValidationMinimum)
+	} else {
+		d.Set("validation_minimum", nil)
+	}
+
+	if result.ValidationMaximum != nil {
+		d.Set("validation_maximum", result.ValidationMaximum)
+	} else {
+		d.Set("validation_maximum", nil)
+	}
+
+	d.Set("validation_regex", result.ValidationRegex)
+
+	return diags
+}
+
+func resourceExtrasCustomFieldUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/extras/custom-fields/%d/", objectID), resourceExtrasCustomFieldData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update custom field", err, resourceExtrasCustomField().Schema)
+	}
+
+	return resourceExtrasCustomFieldRead(ctx, d, m)
+}
+
+func resourceExtrasCustomFieldDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/extras/custom-fields/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete custom field", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceExtrasCustomFieldData returns the request body for creating and
+// updating custom fields. The validation bounds are checked with
+// GetOkExists, as 0 is a meaningful minimum or maximum.
+func resourceExtrasCustomFieldData(d *schema.ResourceData) map[string]interface{} {
+	contentTypes := make([]string, 0)
+	for _, v := range d.Get("content_types").(*schema.Set).List() {
+		contentTypes = append(contentTypes, v.(string))
+	}
+
+	choices := make([]string, 0)
+	for _, v := range d.Get("choices").([]interface{}) {
+		choices = append(choices, v.(string))
+	}
+
+	data := map[string]interface{}{
+		"name":             d.Get("name").(string),
+		"label":            d.Get("label").(string),
+		"type":             d.Get("type").(string),
+		"content_types":    contentTypes,
+		"description":      d.Get("description").(string),
+		"required":         d.Get("required").(bool),
+		"weight":           d.Get("weight").(int),
+		"filter_logic":     d.Get("filter_logic").(string),
+		"choices":          choices,
+		"validation_regex": d.Get("validation_regex").(string),
+	}
+
+	if v, ok := d.GetOk("default"); ok {
+		data["default"] = json.RawMessage(v.(string))
+	} else {
+		data["default"] = nil
+	}
+
+	if v, ok := d.GetOkExists("validation_minimum"); ok {
+		data["validation_minimum"] = v.(int)
+	} else {
+		data["validation_minimum"] = nil
+	}
+
+	if v, ok := d.GetOkExists("validation_maximum"); ok {
+		data["validation_maximum"] = v.(int)
+	} else {
+		data["validation_maximum"] = nil
+	}
+
+	return data
+}
+
+// resourceExtrasCustomFieldResolveName resolves a custom field name, which is
+// unique in NetBox.
+func resourceExtrasCustomFieldResolveName(ctx context.Context, c *client.NetBoxAPI, name string) (int64, error) {
+	var result struct {
+		Results []struct {
+			ID int64 `json:"id"`
+		} `json:"results"`
+	}
+
+	query := url.Values{"name": {name}}
+
+	err := netboxRequest(ctx, c, "GET", "/extras/custom-fields/?"+query.Encode(), nil, &result)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list custom fields: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(result.Results))
+	for _, v := range result.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("custom field", name, ids)
+}
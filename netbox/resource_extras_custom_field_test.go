@@ -0,0 +1,209 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestResourceExtrasCustomFieldCreate(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == "POST" {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+
+			if body["validation_minimum"] != float64(0) {
+				t.Errorf("expected validation_minimum 0, got %#v", body["validation_minimum"])
+			}
+
+			if v, ok := body["validation_maximum"]; !ok || v != nil {
+				t.Errorf("expected validation_maximum null, got %#v", v)
+			}
+
+			if !reflect.DeepEqual(body["default"], map[string]interface{}{"amount": float64(5)}) {
+				t.Errorf("expected default to be sent as JSON, got %#v", body["default"])
+			}
+		}
+
+		w.Write([]byte(`{
+			"id": 4,
+			"content_types": ["dcim.device", "virtualization.virtualmachine"],
+			"type": {"value": "json", "label": "JSON"},
+			"name": "budget",
+			"label": "",
+			"description": "",
+			"required": false,
+			"filter_logic": {"value": "loose", "label": "Loose"},
+			"default": {"amount": 5},
+			"weight": 100,
+			"validation_minimum": 0,
+			"validation_maximum": null,
+			"validation_regex": "",
+			"choices": []
+		}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceExtrasCustomField().Schema, map[string]interface{}{
+		"name":               "budget",
+		"type":               "json",
+		"content_types":      []interface{}{"virtualization.virtualmachine", "dcim.device"},
+		"default":            `{ "amount": 5 }`,
+		"validation_minimum": 0,
+	})
+
+	if diags := resourceExtrasCustomFieldCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Get("default").(string) != `{"amount":5}` {
+		t.Fatalf("expected normalized default, got %q", d.Get("default"))
+	}
+
+	if d.Get("content_types").(*schema.Set).Len() != 2 {
+		t.Fatalf("unexpected content_types %v", d.Get("content_types"))
+	}
+}
+
+func TestResourceExtrasCustomFieldCustomizeDiff_bounds(t *testing.T) {
+	for name, tt := range map[string]struct {
+		minimum, maximum int
+		valid            bool
+	}{
+		"ordered":      {1, 10, true},
+		"equal":        {5, 5, true},
+		"zero minimum": {0, 10, true},
+		"reversed":     {10, 1, false},
+		"zero maximum": {5, 0, false},
+	} {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":               "amount",
+			"type":               "integer",
+			"content_types":      []interface{}{"dcim.device"},
+			"validation_minimum": tt.minimum,
+			"validation_maximum": tt.maximum,
+		})
+
+		_, err := resourceExtrasCustomField().Diff(context.Background(), nil, config, nil)
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+
+		if !tt.valid && err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestAccExtrasCustomField_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckExtrasCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckExtrasCustomFieldConfigBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExtrasCustomFieldExists("netbox_extras_custom_field.test"),
+					resource.TestCheckResourceAttr("netbox_extras_custom_field.test", "type", "select"),
+					resource.TestCheckResourceAttr("netbox_extras_custom_field.test", "content_types.#", "2"),
+					resource.TestCheckResourceAttr("netbox_extras_custom_field.test", "choices.1", "silver"),
+					resource.TestCheckResourceAttr("netbox_extras_custom_field.test", "default", `"gold"`),
+					resource.TestCheckResourceAttr("netbox_extras_custom_field.test", "filter_logic", "exact"),
+				),
+			},
+			{
+				Config: testAccCheckExtrasCustomFieldConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_extras_custom_field.test", "type", "text"),
+					resource.TestCheckResourceAttr("netbox_extras_custom_field.test", "choices.#", "0"),
+					resource.TestCheckResourceAttr("netbox_extras_custom_field.test", "default", ""),
+					resource.TestCheckResourceAttr("netbox_extras_custom_field.test", "validation_regex", "^[a-z]+$"),
+				),
+			},
+			{
+				ResourceName:      "netbox_extras_custom_field.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_extras_custom_field.test",
+				ImportState:       true,
+				ImportStateId:     "test_custom_field",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckExtrasCustomFieldDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_extras_custom_field" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/extras/custom-fields/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Custom field ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckExtrasCustomFieldExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No custom field ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/extras/custom-fields/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+const testAccCheckExtrasCustomFieldConfigBasic = `
+resource "netbox_extras_custom_field" "test" {
+  name          = "test_custom_field"
+  label         = "Test custom field"
+  type          = "select"
+  content_types = ["dcim.device", "dcim.site"]
+  choices       = ["gold", "silver"]
+  default       = jsonencode("gold")
+  filter_logic  = "exact"
+}
+`
+
+const testAccCheckExtrasCustomFieldConfigUpdate = `
+resource "netbox_extras_custom_field" "test" {
+  name             = "test_custom_field"
+  content_types    = ["dcim.device"]
+  validation_regex = "^[a-z]+$"
+}
+`
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/extras"
 	"github.com/netbox-community/go-netbox/netbox/models"
@@ -20,6 +20,10 @@ func resourceExtrasTag() *schema.Resource {
 		UpdateContext: resourceExtrasTagUpdate,
 		DeleteContext: resourceExtrasTagDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceExtrasTagResolveSlug),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -73,7 +77,7 @@ func resourceExtrasTagCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 	resp, err := c.Extras.ExtrasTagsCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create tag: %v", err)
+		return apiErrorDiags("Unable to create tag", err, resourceExtrasTag().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -100,12 +104,12 @@ func resourceExtrasTagRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	resp, err := c.Extras.ExtrasTagsRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get tag: %v", err)
+		return apiErrorDiags("Unable to get tag", err, nil)
 	}
 
 	d.Set("name", resp.Payload.Name)
@@ -147,7 +151,7 @@ func resourceExtrasTagUpdate(ctx context.Context, d *schema.ResourceData, m inte
 
 	_, err = c.Extras.ExtrasTagsPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update tag: %v", err)
+		return apiErrorDiags("Unable to update tag", err, resourceExtrasTag().Schema)
 	}
 
 	return resourceExtrasTagRead(ctx, d, m)
@@ -169,11 +173,30 @@ func resourceExtrasTagDelete(ctx context.Context, d *schema.ResourceData, m inte
 	}
 
 	_, err = c.Extras.ExtrasTagsDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete tag: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete tag", err, nil)
 	}
 
 	d.SetId("")
 
 	return diags
 }
+
+func resourceExtrasTagResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &extras.ExtrasTagsListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Extras.ExtrasTagsList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list tags: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("tag", slug, ids)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/ipam"
 	"github.com/netbox-community/go-netbox/netbox/models"
@@ -20,6 +19,10 @@ func resourceIpamAggregate() *schema.Resource {
 		UpdateContext: resourceIpamAggregateUpdate,
 		DeleteContext: resourceIpamAggregateDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"prefix": {
 				Type:             schema.TypeString,
@@ -62,7 +65,7 @@ func resourceIpamAggregateCreate(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := c.Ipam.IpamAggregatesCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create aggregate: %v", err)
+		return apiErrorDiags("Unable to create aggregate", err, resourceIpamAggregate().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -89,17 +92,21 @@ func resourceIpamAggregateRead(ctx context.Context, d *schema.ResourceData, m in
 
 	resp, err := c.Ipam.IpamAggregatesRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get aggregate: %v", err)
+		return apiErrorDiags("Unable to get aggregate", err, nil)
 	}
 
 	d.Set("family", resp.Payload.Family.Label)
 	d.Set("prefix", resp.Payload.Prefix)
 
+	if resp.Payload.Rir != nil {
+		d.Set("rir_id", resp.Payload.Rir.ID)
+	}
+
 	return diags
 }
 
@@ -124,7 +131,7 @@ func resourceIpamAggregateUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = c.Ipam.IpamAggregatesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update aggregate: %v", err)
+		return apiErrorDiags("Unable to update aggregate", err, resourceIpamAggregate().Schema)
 	}
 
 	return resourceIpamAggregateRead(ctx, d, m)
@@ -146,8 +153,8 @@ func resourceIpamAggregateDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	_, err = c.Ipam.IpamAggregatesDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete aggregate: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete aggregate", err, nil)
 	}
 
 	d.SetId("")
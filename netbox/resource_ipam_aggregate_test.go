@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -27,6 +26,11 @@ func TestAccIpamAggregate_basic(t *testing.T) {
 					testAccCheckIpamAggregateExists("netbox_ipam_aggregates.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_ipam_aggregates.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -51,7 +55,7 @@ func testAccCheckIpamAggregateDestroy(s *terraform.State) error {
 
 		resp, err := c.Ipam.IpamAggregatesRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
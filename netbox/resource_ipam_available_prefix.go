@@ -2,9 +2,10 @@ package netbox
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/ipam"
 	"github.com/netbox-community/go-netbox/netbox/models"
@@ -19,6 +20,10 @@ func resourceIpamAvailablePrefix() *schema.Resource {
 		ReadContext:   resourceIpamAvailablePrefixRead,
 		DeleteContext: resourceIpamAvailablePrefixDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIpamAvailablePrefixImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"prefix_id": {
 				Type:     schema.TypeInt,
@@ -62,7 +67,7 @@ func resourceIpamAvailablePrefixCreate(ctx context.Context, d *schema.ResourceDa
 
 	resp, err := c.Ipam.IpamPrefixesAvailablePrefixesCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create available prefix: %v", err)
+		return apiErrorDiags("Unable to create available prefix", err, resourceIpamAvailablePrefix().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -89,12 +94,12 @@ func resourceIpamAvailablePrefixRead(ctx context.Context, d *schema.ResourceData
 
 	resp, err := c.Ipam.IpamPrefixesRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get prefix: %v", err)
+		return apiErrorDiags("Unable to get prefix", err, nil)
 	}
 
 	d.Set("family", resp.Payload.Family.Value)
@@ -119,11 +124,84 @@ func resourceIpamAvailablePrefixDelete(ctx context.Context, d *schema.ResourceDa
 	}
 
 	_, err = c.Ipam.IpamPrefixesDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete prefix: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete prefix", err, nil)
 	}
 
 	d.SetId("")
 
 	return diags
 }
+
+// resourceIpamAvailablePrefixImport recovers the arguments the prefix was
+// allocated with: the prefix length comes from the prefix itself and the
+// parent is the most specific prefix containing it within the same VRF.
+func resourceIpamAvailablePrefixImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse ID: %v", err)
+	}
+
+	resp, err := c.Ipam.IpamPrefixesRead(&ipam.IpamPrefixesReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get prefix: %s", errorDetail(err))
+	}
+
+	_, ipNet, err := net.ParseCIDR(*resp.Payload.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse prefix %s: %v", *resp.Payload.Prefix, err)
+	}
+
+	prefixLength, _ := ipNet.Mask.Size()
+
+	vrfID := "null"
+	if resp.Payload.Vrf != nil {
+		vrfID = strconv.FormatInt(resp.Payload.Vrf.ID, 10)
+	}
+
+	contains := ipNet.String()
+	limit := int64(0)
+
+	parents, err := c.Ipam.IpamPrefixesList(&ipam.IpamPrefixesListParams{
+		Context:  ctx,
+		Contains: &contains,
+		VrfID:    &vrfID,
+		Limit:    &limit,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to list parent prefixes: %s", errorDetail(err))
+	}
+
+	var parentID int64
+	parentLength := -1
+
+	for _, p := range parents.Payload.Results {
+		if p.ID == objectID || p.Prefix == nil {
+			continue
+		}
+
+		_, parentNet, err := net.ParseCIDR(*p.Prefix)
+		if err != nil {
+			continue
+		}
+
+		if length, _ := parentNet.Mask.Size(); length < prefixLength && length > parentLength {
+			parentID = p.ID
+			parentLength = length
+		}
+	}
+
+	if parentLength < 0 {
+		return nil, fmt.Errorf("Unable to find a parent prefix for %s", ipNet)
+	}
+
+	d.Set("prefix_id", parentID)
+	d.Set("prefix_length", prefixLength)
+
+	return []*schema.ResourceData{d}, nil
+}
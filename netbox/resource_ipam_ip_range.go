@@ -0,0 +1,367 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ipamIPRange decodes IP ranges returned by NetBox. IP ranges were added in
+// NetBox 3.0 and go-netbox has no model or client for them, so they are
+// managed entirely through netboxRequest. The size is decoded as a number,
+// as IPv6 ranges can hold more addresses than fit in an int64.
+type ipamIPRange struct {
+	ID           int64                `json:"id"`
+	StartAddress string               `json:"start_address"`
+	EndAddress   string               `json:"end_address"`
+	Size         json.Number          `json:"size"`
+	Vrf          *models.NestedVRF    `json:"vrf"`
+	Tenant       *models.NestedTenant `json:"tenant"`
+	Status       *struct {
+		Value string `json:"value"`
+	} `json:"status"`
+	Role         *models.NestedRole  `json:"role"`
+	Description  string              `json:"description"`
+	Tags         []*models.NestedTag `json:"tags"`
+	CustomFields interface{}         `json:"custom_fields"`
+}
+
+func resourceIpamIPRange() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpamIPRangeCreate,
+		ReadContext:   resourceIpamIPRangeRead,
+		UpdateContext: resourceIpamIPRangeUpdate,
+		DeleteContext: resourceIpamIPRangeDelete,
+
+		CustomizeDiff: resourceIpamIPRangeCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"start_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"end_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"vrf_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					"active",
+					"reserved",
+					"deprecated",
+				}),
+
+				Default: "active",
+			},
+
+			"role_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceIpamIPRangeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("start_address") || !d.NewValueKnown("end_address") {
+		return d.SetNewComputed("size")
+	}
+
+	size, err := ipamIPRangeSize(d.Get("start_address").(string), d.Get("end_address").(string))
+	if err != nil {
+		return err
+	}
+
+	if !d.HasChange("start_address") && !d.HasChange("end_address") {
+		return nil
+	}
+
+	if !size.IsInt64() {
+		return d.SetNewComputed("size")
+	}
+
+	return d.SetNew("size", int(size.Int64()))
+}
+
+// ipamIPRangeSize returns the number of addresses from start to end, both
+// given with a prefix length, the way NetBox computes the size of an IP
+// range. It fails for ranges NetBox would reject: addresses of different
+// families or prefix lengths, and a start above the end.
+func ipamIPRangeSize(start, end string) (*big.Int, error) {
+	startIP, startNet, err := net.ParseCIDR(start)
+	if err != nil {
+		return nil, fmt.Errorf("start_address: %q isn't an IP address with a prefix length", start)
+	}
+
+	endIP, endNet, err := net.ParseCIDR(end)
+	if err != nil {
+		return nil, fmt.Errorf("end_address: %q isn't an IP address with a prefix length", end)
+	}
+
+	if (startIP.To4() == nil) != (endIP.To4() == nil) {
+		return nil, fmt.Errorf("start_address %s and end_address %s must be in the same address family", start, end)
+	}
+
+	startOnes, _ := startNet.Mask.Size()
+	endOnes, _ := endNet.Mask.Size()
+
+	if startOnes != endOnes {
+		return nil, fmt.Errorf("start_address %s and end_address %s must have the same prefix length", start, end)
+	}
+
+	size := new(big.Int).Sub(ipamIPRangeInt(endIP), ipamIPRangeInt(startIP))
+	if size.Sign() < 0 {
+		return nil, fmt.Errorf("start_address %s must not be above end_address %s", start, end)
+	}
+
+	return size.Add(size, big.NewInt(1)), nil
+}
+
+func ipamIPRangeInt(ip net.IP) *big.Int {
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+
+	return new(big.Int).SetBytes(ip)
+}
+
+func resourceIpamIPRangeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result ipamIPRange
+
+	err := netboxRequest(ctx, c, "POST", "/ipam/ip-ranges/", resourceIpamIPRangeData(d), &result)
+	if err != nil {
+		return ipamIPRangeErrorDiags(ctx, c, "Unable to create IP range", err, resourceIpamIPRange().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceIpamIPRangeRead(ctx, d, m)
+}
+
+func resourceIpamIPRangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result ipamIPRange
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/ipam/ip-ranges/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) && ipamIPRangesSupported(ctx, c) {
+			d.SetId("")
+			return nil
+		}
+
+		return ipamIPRangeErrorDiags(ctx, c, "Unable to get IP range", err, nil)
+	}
+
+	d.Set("start_address", result.StartAddress)
+	d.Set("end_address", result.EndAddress)
+
+	if size, err := result.Size.Int64(); err == nil {
+		d.Set("size", size)
+	} else {
+		d.Set("size", nil)
+	}
+
+	if result.Vrf != nil {
+		d.Set("vrf_id", result.Vrf.ID)
+	} else {
+		d.Set("vrf_id", nil)
+	}
+
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	if result.Status != nil {
+		d.Set("status", result.Status.Value)
+	}
+
+	if result.Role != nil {
+		d.Set("role_id", result.Role.ID)
+	} else {
+		d.Set("role_id", nil)
+	}
+
+	d.Set("description", result.Description)
+	d.Set("tags", flattenTags(result.Tags))
+	d.Set("custom_fields", flattenCustomFields(result.CustomFields))
+
+	return diags
+}
+
+func resourceIpamIPRangeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/ipam/ip-ranges/%d/", objectID), resourceIpamIPRangeData(d), nil)
+	if err != nil {
+		return ipamIPRangeErrorDiags(ctx, c, "Unable to update IP range", err, resourceIpamIPRange().Schema)
+	}
+
+	return resourceIpamIPRangeRead(ctx, d, m)
+}
+
+func resourceIpamIPRangeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/ipam/ip-ranges/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete IP range", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// ipamIPRangesSupported reports whether NetBox has the IP ranges endpoint.
+// NetBox versions older than 3.0 answer requests for it with 404, just like
+// requests for an IP range that doesn't exist.
+func ipamIPRangesSupported(ctx context.Context, c *client.NetBoxAPI) bool {
+	err := netboxRequest(ctx, c, "GET", "/ipam/ip-ranges/?limit=1", nil, nil)
+
+	return !isNotFound(err)
+}
+
+// ipamIPRangeErrorDiags returns the diagnostics for a failed IP range request,
+// explaining a 404 caused by a NetBox version without IP ranges.
+func ipamIPRangeErrorDiags(ctx context.Context, c *client.NetBoxAPI, summary string, err error, s map[string]*schema.Schema) diag.Diagnostics {
+	if isNotFound(err) && !ipamIPRangesSupported(ctx, c) {
+		return diag.Diagnostics{errorDiag(summary, "IP ranges require NetBox 3.0 or later, and this NetBox server has no IP ranges endpoint.", nil)}
+	}
+
+	return apiErrorDiags(summary, err, s)
+}
+
+// resourceIpamIPRangeData returns the request body for creating and updating
+// IP ranges.
+func resourceIpamIPRangeData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"start_address": d.Get("start_address").(string),
+		"end_address":   d.Get("end_address").(string),
+		"status":        d.Get("status").(string),
+		"description":   d.Get("description").(string),
+		"tags":          requestTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("vrf_id"); ok {
+		data["vrf"] = v.(int)
+	} else {
+		data["vrf"] = nil
+	}
+
+	if v, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = v.(int)
+	} else {
+		data["tenant"] = nil
+	}
+
+	if v, ok := d.GetOk("role_id"); ok {
+		data["role"] = v.(int)
+	} else {
+		data["role"] = nil
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
@@ -0,0 +1,195 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestIpamIPRangeSize(t *testing.T) {
+	valid := map[string]struct {
+		start, end string
+		expected   string
+	}{
+		"ipv4":          {"10.0.0.10/24", "10.0.0.99/24", "90"},
+		"single":        {"10.0.0.10/24", "10.0.0.10/24", "1"},
+		"across octets": {"10.0.0.250/16", "10.0.1.5/16", "12"},
+		"ipv6":          {"2001:db8::10/64", "2001:db8::1:f/64", "65536"},
+		"ipv6 large":    {"2001:db8::/64", "2001:db8::ffff:ffff:ffff:ffff/64", "18446744073709551616"},
+	}
+
+	for name, tt := range valid {
+		size, err := ipamIPRangeSize(tt.start, tt.end)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+
+		if size.String() != tt.expected {
+			t.Errorf("%s: expected size %s, got %s", name, tt.expected, size)
+		}
+	}
+
+	invalid := map[string]struct {
+		start, end string
+		expected   string
+	}{
+		"family":        {"10.0.0.1/24", "2001:db8::1/24", "same address family"},
+		"prefix length": {"10.0.0.1/24", "10.0.0.9/25", "same prefix length"},
+		"reversed":      {"10.0.0.9/24", "10.0.0.1/24", "must not be above"},
+		"no mask":       {"10.0.0.1", "10.0.0.9/24", "start_address"},
+		"garbage":       {"10.0.0.1/24", "foo", "end_address"},
+	}
+
+	for name, tt := range invalid {
+		_, err := ipamIPRangeSize(tt.start, tt.end)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("%s: expected error containing %q, got %v", name, tt.expected, err)
+		}
+	}
+}
+
+func TestResourceIpamIPRangeRead_notFound(t *testing.T) {
+	for name, tt := range map[string]struct {
+		listStatus int
+		expected   string
+	}{
+		"deleted":      {http.StatusOK, ""},
+		"netbox < 3.0": {http.StatusNotFound, "require NetBox 3.0"},
+	} {
+		c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			if r.URL.Path == "/api/ipam/ip-ranges/" {
+				w.WriteHeader(tt.listStatus)
+				w.Write([]byte(`{"count": 0, "results": []}`))
+				return
+			}
+
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		})
+
+		d := schema.TestResourceDataRaw(t, resourceIpamIPRange().Schema, map[string]interface{}{})
+		d.SetId("7")
+
+		diags := resourceIpamIPRangeRead(context.Background(), d, c)
+
+		if tt.expected == "" {
+			if diags.HasError() {
+				t.Errorf("%s: unexpected error: %v", name, diags)
+			}
+
+			if d.Id() != "" {
+				t.Errorf("%s: expected the IP range to be removed from state", name)
+			}
+
+			continue
+		}
+
+		if len(diags) != 1 || !strings.Contains(diags[0].Detail, tt.expected) {
+			t.Errorf("%s: expected an error containing %q, got %v", name, tt.expected, diags)
+		}
+	}
+}
+
+func TestAccIpamIPRange_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpamIPRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIpamIPRangeConfigBasic("10.0.23.100/24", "10.0.23.199/24", "reserved"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpamIPRangeExists("netbox_ipam_ip_range.test"),
+					resource.TestCheckResourceAttr("netbox_ipam_ip_range.test", "size", "100"),
+					resource.TestCheckResourceAttr("netbox_ipam_ip_range.test", "status", "reserved"),
+					resource.TestCheckResourceAttrPair("netbox_ipam_ip_range.test", "vrf_id", "netbox_ipam_vrf.test-ip-range", "id"),
+					resource.TestCheckResourceAttrPair("netbox_ipam_ip_range.test", "role_id", "netbox_ipam_role.test-ip-range", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIpamIPRangeConfigBasic("10.0.23.100/24", "10.0.23.149/24", "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipam_ip_range.test", "size", "50"),
+					resource.TestCheckResourceAttr("netbox_ipam_ip_range.test", "status", "active"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipam_ip_range.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpamIPRangeDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_ipam_ip_range" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/ipam/ip-ranges/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("IP range ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIpamIPRangeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IP range ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/ipam/ip-ranges/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+func testAccCheckIpamIPRangeConfigBasic(start, end, status string) string {
+	return fmt.Sprintf(`
+resource "netbox_ipam_vrf" "test-ip-range" {
+  name = "test-ip-range"
+}
+
+resource "netbox_ipam_role" "test-ip-range" {
+  name = "test-ip-range"
+  slug = "test-ip-range"
+}
+
+resource "netbox_ipam_ip_range" "test" {
+  start_address = "%s"
+  end_address   = "%s"
+  status        = "%s"
+  vrf_id        = netbox_ipam_vrf.test-ip-range.id
+  role_id       = netbox_ipam_role.test-ip-range.id
+}
+`, start, end, status)
+}
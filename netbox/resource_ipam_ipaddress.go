@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/ipam"
 	"github.com/netbox-community/go-netbox/netbox/models"
@@ -13,6 +13,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ipamIPAddressAssignedObjectPaths maps the types of object an IP address can
+// be assigned to to the API paths they are read from.
+var ipamIPAddressAssignedObjectPaths = map[string]string{
+	"dcim.interface":             "/dcim/interfaces/",
+	"virtualization.vminterface": "/virtualization/interfaces/",
+}
+
 func resourceIpamIPAddress() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIpamIPAddressCreate,
@@ -20,6 +27,12 @@ func resourceIpamIPAddress() *schema.Resource {
 		UpdateContext: resourceIpamIPAddressUpdate,
 		DeleteContext: resourceIpamIPAddressDelete,
 
+		CustomizeDiff: resourceIpamIPAddressCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceIpamIPAddressResolveCIDR),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"address": {
 				Type:     schema.TypeString,
@@ -79,6 +92,10 @@ func resourceIpamIPAddress() *schema.Resource {
 			"assigned_object_type": {
 				Type:     schema.TypeString,
 				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					"dcim.interface",
+					"virtualization.vminterface",
+				}),
 			},
 
 			"dns_name": {
@@ -130,6 +147,50 @@ func resourceIpamIPAddress() *schema.Resource {
 	}
 }
 
+func resourceIpamIPAddressCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("assigned_object_type") || !d.NewValueKnown("assigned_object_id") {
+		return nil
+	}
+
+	objectType := d.Get("assigned_object_type").(string)
+	objectID := d.Get("assigned_object_id").(int)
+
+	switch {
+	case objectType == "" && objectID == 0:
+		return nil
+	case objectType == "":
+		return fmt.Errorf("assigned_object_type must be set when assigned_object_id is set")
+	case objectID == 0:
+		return fmt.Errorf("assigned_object_id must be set when assigned_object_type is set")
+	}
+
+	if !d.HasChange("assigned_object_type") && !d.HasChange("assigned_object_id") {
+		return nil
+	}
+
+	return resourceIpamIPAddressCheckAssignedObject(ctx, m.(*client.NetBoxAPI), objectType, int64(objectID))
+}
+
+// resourceIpamIPAddressCheckAssignedObject fails unless the object an IP
+// address is assigned to exists, so that a wrong ID is reported at plan time.
+func resourceIpamIPAddressCheckAssignedObject(ctx context.Context, c *client.NetBoxAPI, objectType string, objectID int64) error {
+	path, ok := ipamIPAddressAssignedObjectPaths[objectType]
+	if !ok {
+		return nil
+	}
+
+	err := netboxRequest(ctx, c, "GET", fmt.Sprintf("%s%d/", path, objectID), nil, nil)
+	if err != nil {
+		if isNotFound(err) {
+			return fmt.Errorf("assigned_object_id %d doesn't match any %s", objectID, objectType)
+		}
+
+		return fmt.Errorf("Unable to get %s %d: %s", objectType, objectID, errorDetail(err))
+	}
+
+	return nil
+}
+
 func resourceIpamIPAddressCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.NetBoxAPI)
 
@@ -194,7 +255,7 @@ func resourceIpamIPAddressCreate(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := c.Ipam.IpamIPAddressesCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create address: %v", err)
+		return apiErrorDiags("Unable to create address", err, resourceIpamIPAddress().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -221,12 +282,12 @@ func resourceIpamIPAddressRead(ctx context.Context, d *schema.ResourceData, m in
 
 	resp, err := c.Ipam.IpamIPAddressesRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get address: %v", err)
+		return apiErrorDiags("Unable to get address", err, nil)
 	}
 
 	d.Set("address", resp.Payload.Address)
@@ -269,7 +330,7 @@ func resourceIpamIPAddressRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
 
 	return diags
 }
@@ -348,7 +409,7 @@ func resourceIpamIPAddressUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = c.Ipam.IpamIPAddressesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update address: %v", err)
+		return apiErrorDiags("Unable to update address", err, resourceIpamIPAddress().Schema)
 	}
 
 	return resourceIpamIPAddressRead(ctx, d, m)
@@ -370,11 +431,41 @@ func resourceIpamIPAddressDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	_, err = c.Ipam.IpamIPAddressesDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete address: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete address", err, nil)
 	}
 
 	d.SetId("")
 
 	return diags
 }
+
+func resourceIpamIPAddressResolveCIDR(ctx context.Context, c *client.NetBoxAPI, key string) (int64, error) {
+	vrf, address, err := splitVRFImportKey(key)
+	if err != nil {
+		return 0, err
+	}
+
+	vrfID, err := resolveVRFFilter(ctx, c, vrf)
+	if err != nil {
+		return 0, err
+	}
+
+	params := &ipam.IpamIPAddressesListParams{
+		Context: ctx,
+		Address: &address,
+		VrfID:   &vrfID,
+	}
+
+	resp, err := c.Ipam.IpamIPAddressesList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list IP addresses: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("IP address", key, ids)
+}
@@ -3,16 +3,53 @@ package netbox
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/ipam"
 )
 
+func TestResourceIpamIPAddressCheckAssignedObject(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/dcim/interfaces/11/", "/api/virtualization/interfaces/17/":
+			w.Write([]byte(`{"id":1}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail":"Not found."}`))
+		}
+	})
+
+	cases := []struct {
+		name       string
+		objectType string
+		objectID   int64
+		err        string
+	}{
+		{"device interface", "dcim.interface", 11, ""},
+		{"vm interface", "virtualization.vminterface", 17, ""},
+		{"missing vm interface", "virtualization.vminterface", 11, "assigned_object_id 11 doesn't match any virtualization.vminterface"},
+	}
+
+	for _, tc := range cases {
+		err := resourceIpamIPAddressCheckAssignedObject(context.Background(), c, tc.objectType, tc.objectID)
+
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+			t.Errorf("%s: expected an error containing %q, got %v", tc.name, tc.err, err)
+		}
+	}
+}
+
 func TestAccIpamIPAddress_basic(t *testing.T) {
 	address := "10.0.0.1/24"
 
@@ -27,6 +64,17 @@ func TestAccIpamIPAddress_basic(t *testing.T) {
 					testAccCheckIpamIPAddressExists("netbox_ipam_ipaddress.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_ipam_ipaddress.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_ipam_ipaddress.test",
+				ImportState:       true,
+				ImportStateId:     "/" + address,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -51,7 +99,7 @@ func testAccCheckIpamIPAddressDestroy(s *terraform.State) error {
 
 		resp, err := c.Ipam.IpamIPAddressesRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
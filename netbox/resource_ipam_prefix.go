@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/ipam"
 	"github.com/netbox-community/go-netbox/netbox/models"
@@ -20,6 +20,10 @@ func resourceIpamPrefix() *schema.Resource {
 		UpdateContext: resourceIpamPrefixUpdate,
 		DeleteContext: resourceIpamPrefixDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceIpamPrefixResolveCIDR),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"prefix": {
 				Type:             schema.TypeString,
@@ -177,7 +181,7 @@ func resourceIpamPrefixCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	resp, err := c.Ipam.IpamPrefixesCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create prefix: %v", err)
+		return apiErrorDiags("Unable to create prefix", err, resourceIpamPrefix().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -204,12 +208,12 @@ func resourceIpamPrefixRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	resp, err := c.Ipam.IpamPrefixesRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get prefix: %v", err)
+		return apiErrorDiags("Unable to get prefix", err, nil)
 	}
 
 	d.Set("family", resp.Payload.Family.Label)
@@ -242,7 +246,7 @@ func resourceIpamPrefixRead(ctx context.Context, d *schema.ResourceData, m inter
 
 	d.Set("is_pool", resp.Payload.IsPool)
 	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
 
 	return diags
 }
@@ -313,7 +317,7 @@ func resourceIpamPrefixUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 	_, err = c.Ipam.IpamPrefixesPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update prefix: %v", err)
+		return apiErrorDiags("Unable to update prefix", err, resourceIpamPrefix().Schema)
 	}
 
 	return resourceIpamPrefixRead(ctx, d, m)
@@ -335,8 +339,8 @@ func resourceIpamPrefixDelete(ctx context.Context, d *schema.ResourceData, m int
 	}
 
 	_, err = c.Ipam.IpamPrefixesDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete prefix: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete prefix", err, nil)
 	}
 
 	d.SetId("")
@@ -399,3 +403,60 @@ func flattenTags(input []*models.NestedTag) []interface{} {
 
 	return result
 }
+
+// flattenCustomFields converts the custom fields returned by NetBox into the
+// string map used by the custom_fields attribute. Fields without a value are
+// left out so that unset custom fields don't show up as a diff.
+func flattenCustomFields(input interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+
+	values, ok := input.(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	for k, v := range values {
+		switch v := v.(type) {
+		case nil:
+			continue
+		case string:
+			result[k] = v
+		case float64:
+			result[k] = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			result[k] = fmt.Sprint(v)
+		}
+	}
+
+	return result
+}
+
+func resourceIpamPrefixResolveCIDR(ctx context.Context, c *client.NetBoxAPI, key string) (int64, error) {
+	vrf, prefix, err := splitVRFImportKey(key)
+	if err != nil {
+		return 0, err
+	}
+
+	vrfID, err := resolveVRFFilter(ctx, c, vrf)
+	if err != nil {
+		return 0, err
+	}
+
+	params := &ipam.IpamPrefixesListParams{
+		Context: ctx,
+		Prefix:  &prefix,
+		VrfID:   &vrfID,
+	}
+
+	resp, err := c.Ipam.IpamPrefixesList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list prefixes: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("prefix", key, ids)
+}
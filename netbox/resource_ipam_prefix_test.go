@@ -3,10 +3,10 @@ package netbox
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -27,6 +27,17 @@ func TestAccIpamPrefix_basic(t *testing.T) {
 					testAccCheckIpamPrefixExists("netbox_ipam_prefix.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_ipam_prefix.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_ipam_prefix.test",
+				ImportState:       true,
+				ImportStateId:     "/" + prefix,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -51,7 +62,7 @@ func testAccCheckIpamPrefixDestroy(s *terraform.State) error {
 
 		resp, err := c.Ipam.IpamPrefixesRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
@@ -116,3 +127,26 @@ resource "netbox_ipam_prefix" "test" {
 }
 `, prefix)
 }
+
+func TestFlattenCustomFields(t *testing.T) {
+	input := map[string]interface{}{
+		"text":    "value",
+		"integer": float64(42),
+		"boolean": true,
+		"unset":   nil,
+	}
+
+	expected := map[string]interface{}{
+		"text":    "value",
+		"integer": "42",
+		"boolean": "true",
+	}
+
+	if actual := flattenCustomFields(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	if actual := flattenCustomFields(nil); len(actual) != 0 {
+		t.Fatalf("expected an empty map, got %#v", actual)
+	}
+}
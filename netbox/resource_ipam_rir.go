@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/ipam"
 	"github.com/netbox-community/go-netbox/netbox/models"
@@ -20,6 +20,10 @@ func resourceIpamRir() *schema.Resource {
 		UpdateContext: resourceIpamRirUpdate,
 		DeleteContext: resourceIpamRirDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceIpamRirResolveSlug),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -52,7 +56,7 @@ func resourceIpamRirCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	resp, err := c.Ipam.IpamRirsCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create rir: %v", err)
+		return apiErrorDiags("Unable to create rir", err, resourceIpamRir().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -79,13 +83,15 @@ func resourceIpamRirRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	resp, err := c.Ipam.IpamRirsRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get rir: %v", err)
+		return apiErrorDiags("Unable to get rir", err, nil)
 	}
+
+	d.Set("name", resp.Payload.Name)
 	d.Set("slug", resp.Payload.Slug)
 
 	return diags
@@ -114,7 +120,7 @@ func resourceIpamRirUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	_, err = c.Ipam.IpamRirsPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update rir: %v", err)
+		return apiErrorDiags("Unable to update rir", err, resourceIpamRir().Schema)
 	}
 
 	return resourceIpamRirRead(ctx, d, m)
@@ -136,11 +142,30 @@ func resourceIpamRirDelete(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	_, err = c.Ipam.IpamRirsDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete rir: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete rir", err, nil)
 	}
 
 	d.SetId("")
 
 	return diags
 }
+
+func resourceIpamRirResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &ipam.IpamRirsListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Ipam.IpamRirsList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list RIRs: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("RIR", slug, ids)
+}
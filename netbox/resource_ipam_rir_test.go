@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -25,6 +24,17 @@ func TestAccIpamRir_basic(t *testing.T) {
 					testAccCheckIpamRirExists("netbox_ipam_rir.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_ipam_rir.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_ipam_rir.test",
+				ImportState:       true,
+				ImportStateId:     "test",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -49,7 +59,7 @@ func testAccCheckIpamRirDestroy(s *terraform.State) error {
 
 		resp, err := c.Ipam.IpamRirsRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
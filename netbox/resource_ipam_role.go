@@ -0,0 +1,218 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/ipam"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ipamRole decodes roles returned by NetBox, including the tags and
+// custom fields the go-netbox model lacks.
+type ipamRole struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Weight      int64  `json:"weight"`
+	Description string `json:"description"`
+	netboxExtras
+}
+
+func resourceIpamRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpamRoleCreate,
+		ReadContext:   resourceIpamRoleRead,
+		UpdateContext: resourceIpamRoleUpdate,
+		DeleteContext: resourceIpamRoleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceIpamRoleResolveSlug),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 50),
+			},
+
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"weight": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1000,
+				ValidateDiagFunc: intBetween(0, 32767),
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceIpamRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result ipamRole
+
+	err := netboxRequest(ctx, c, "POST", "/ipam/roles/", resourceIpamRoleData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create role", err, resourceIpamRole().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceIpamRoleRead(ctx, d, m)
+}
+
+func resourceIpamRoleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result ipamRole
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/ipam/roles/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get role", err, nil)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	d.Set("weight", result.Weight)
+	d.Set("description", result.Description)
+
+	return setExtras(d, "IPAM roles", result.netboxExtras)
+}
+
+func resourceIpamRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/ipam/roles/%d/", objectID), resourceIpamRoleData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update role", err, resourceIpamRole().Schema)
+	}
+
+	return resourceIpamRoleRead(ctx, d, m)
+}
+
+func resourceIpamRoleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/ipam/roles/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete role", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceIpamRoleData returns the request body for creating and
+// updating roles.
+func resourceIpamRoleData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"weight":      d.Get("weight").(int),
+		"description": d.Get("description").(string),
+	}
+
+	if d.HasChange("tags") {
+		data["tags"] = requestTags(d.Get("tags").([]interface{}))
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
+
+func resourceIpamRoleResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &ipam.IpamRolesListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Ipam.IpamRolesList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list roles: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("role", slug, ids)
+}
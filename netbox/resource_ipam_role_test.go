@@ -0,0 +1,137 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/ipam"
+)
+
+func TestAccIpamRole_basic(t *testing.T) {
+	name := "test role"
+	slug := "test-role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpamRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIpamRoleConfigBasic(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpamRoleExists("netbox_ipam_role.test"),
+					resource.TestCheckResourceAttr("netbox_ipam_role.test", "weight", "100"),
+				),
+			},
+			{
+				Config: testAccCheckIpamRoleConfigUpdate(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipam_role.test", "weight", "1000"),
+					resource.TestCheckResourceAttr("netbox_ipam_role.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipam_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_ipam_role.test",
+				ImportState:       true,
+				ImportStateId:     slug,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpamRoleDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_ipam_role" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &ipam.IpamRolesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Ipam.IpamRolesRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Role ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIpamRoleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No role ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &ipam.IpamRolesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Ipam.IpamRolesRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIpamRoleConfigBasic(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_ipam_role" "test" {
+  name        = "%s"
+  slug        = "%s"
+  weight      = 100
+  description = "Acceptance test"
+}
+`, name, slug)
+}
+
+func testAccCheckIpamRoleConfigUpdate(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_ipam_role" "test" {
+  name = "%s"
+  slug = "%s"
+}
+`, name, slug)
+}
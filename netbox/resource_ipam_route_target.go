@@ -0,0 +1,229 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ipamRouteTarget decodes route targets returned by NetBox. Route targets
+// were added in NetBox 2.10 and go-netbox has no model or client for them, so
+// they are managed entirely through netboxRequest.
+type ipamRouteTarget struct {
+	ID           int64                `json:"id"`
+	Name         string               `json:"name"`
+	Tenant       *models.NestedTenant `json:"tenant"`
+	Description  string               `json:"description"`
+	Tags         []*models.NestedTag  `json:"tags"`
+	CustomFields interface{}          `json:"custom_fields"`
+}
+
+func resourceIpamRouteTarget() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpamRouteTargetCreate,
+		ReadContext:   resourceIpamRouteTargetRead,
+		UpdateContext: resourceIpamRouteTargetUpdate,
+		DeleteContext: resourceIpamRouteTargetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceIpamRouteTargetResolveName),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 21),
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceIpamRouteTargetCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result ipamRouteTarget
+
+	err := netboxRequest(ctx, c, "POST", "/ipam/route-targets/", resourceIpamRouteTargetData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create route target", err, resourceIpamRouteTarget().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceIpamRouteTargetRead(ctx, d, m)
+}
+
+func resourceIpamRouteTargetRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result ipamRouteTarget
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/ipam/route-targets/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get route target", err, nil)
+	}
+
+	d.Set("name", result.Name)
+
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	d.Set("description", result.Description)
+	d.Set("tags", flattenTags(result.Tags))
+	d.Set("custom_fields", flattenCustomFields(result.CustomFields))
+
+	return diags
+}
+
+func resourceIpamRouteTargetUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/ipam/route-targets/%d/", objectID), resourceIpamRouteTargetData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update route target", err, resourceIpamRouteTarget().Schema)
+	}
+
+	return resourceIpamRouteTargetRead(ctx, d, m)
+}
+
+func resourceIpamRouteTargetDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/ipam/route-targets/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete route target", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceIpamRouteTargetData returns the request body for creating and
+// updating route targets.
+func resourceIpamRouteTargetData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"description": d.Get("description").(string),
+		"tags":        requestTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("tenant_id"); ok {
+		data["tenant"] = v.(int)
+	} else {
+		data["tenant"] = nil
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
+
+// resourceIpamRouteTargetResolveName resolves a route target name, such as
+// 65000:100, which is unique in NetBox.
+func resourceIpamRouteTargetResolveName(ctx context.Context, c *client.NetBoxAPI, name string) (int64, error) {
+	var result struct {
+		Results []struct {
+			ID int64 `json:"id"`
+		} `json:"results"`
+	}
+
+	query := url.Values{"name": {name}}
+
+	err := netboxRequest(ctx, c, "GET", "/ipam/route-targets/?"+query.Encode(), nil, &result)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list route targets: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(result.Results))
+	for _, v := range result.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("route target", name, ids)
+}
@@ -0,0 +1,115 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestAccIpamRouteTarget_basic(t *testing.T) {
+	name := "65000:1800"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpamRouteTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIpamRouteTargetConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpamRouteTargetExists("netbox_ipam_route_target.test"),
+					resource.TestCheckResourceAttr("netbox_ipam_route_target.test", "name", name),
+					resource.TestCheckResourceAttrPair("netbox_ipam_route_target.test", "tenant_id", "netbox_tenancy_tenant.test-route-target", "id"),
+					resource.TestCheckResourceAttr("netbox_ipam_route_target.test", "description", "Acceptance test"),
+				),
+			},
+			{
+				Config: testAccCheckIpamRouteTargetConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipam_route_target.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipam_route_target.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_ipam_route_target.test",
+				ImportState:       true,
+				ImportStateId:     name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpamRouteTargetDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_ipam_route_target" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/ipam/route-targets/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Route target ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIpamRouteTargetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No route target ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/ipam/route-targets/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+var testAccIpamRouteTargetTenant = `
+resource "netbox_tenancy_tenant" "test-route-target" {
+  name = "test-route-target"
+  slug = "test-route-target"
+}
+`
+
+func testAccCheckIpamRouteTargetConfigBasic(name string) string {
+	return testAccIpamRouteTargetTenant + fmt.Sprintf(`
+resource "netbox_ipam_route_target" "test" {
+  name        = "%s"
+  tenant_id   = netbox_tenancy_tenant.test-route-target.id
+  description = "Acceptance test"
+}
+`, name)
+}
+
+func testAccCheckIpamRouteTargetConfigUpdate(name string) string {
+	return testAccIpamRouteTargetTenant + fmt.Sprintf(`
+resource "netbox_ipam_route_target" "test" {
+  name = "%s"
+}
+`, name)
+}
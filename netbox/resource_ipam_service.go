@@ -0,0 +1,372 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ipamService decodes services returned by NetBox. NetBox 2.10 replaced the
+// single port of a service with a list of ports and added SCTP, neither of
+// which the go-netbox models know about, so services are managed through
+// netboxRequest.
+type ipamService struct {
+	ID             int64                        `json:"id"`
+	Device         *models.NestedDevice         `json:"device"`
+	VirtualMachine *models.NestedVirtualMachine `json:"virtual_machine"`
+	Name           string                       `json:"name"`
+	Protocol       *models.ServiceProtocol      `json:"protocol"`
+	Port           *int64                       `json:"port"`
+	Ports          []int64                      `json:"ports"`
+	Ipaddresses    []*models.NestedIPAddress    `json:"ipaddresses"`
+	Description    string                       `json:"description"`
+	Tags           []*models.NestedTag          `json:"tags"`
+	CustomFields   interface{}                  `json:"custom_fields"`
+}
+
+func resourceIpamService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpamServiceCreate,
+		ReadContext:   resourceIpamServiceRead,
+		UpdateContext: resourceIpamServiceUpdate,
+		DeleteContext: resourceIpamServiceDelete,
+
+		CustomizeDiff: resourceIpamServiceCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_id", "virtual_machine_id"},
+			},
+
+			"virtual_machine_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"device_id", "virtual_machine_id"},
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 100),
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					"tcp",
+					"udp",
+					"sctp",
+				}),
+			},
+
+			"ports": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeInt,
+					ValidateDiagFunc: intBetween(1, 65535),
+				},
+			},
+
+			"ipaddresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceIpamServiceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, k := range []string{"device_id", "virtual_machine_id", "ipaddresses"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	if !d.HasChange("device_id") && !d.HasChange("virtual_machine_id") && !d.HasChange("ipaddresses") {
+		return nil
+	}
+
+	ids := expandIpamServiceIDs(d.Get("ipaddresses").(*schema.Set))
+	if len(ids) == 0 {
+		return nil
+	}
+
+	parentType, parentID := "device", int64(d.Get("device_id").(int))
+	if parentID == 0 {
+		parentType, parentID = "virtual_machine", int64(d.Get("virtual_machine_id").(int))
+	}
+
+	return resourceIpamServiceCheckIPAddresses(ctx, m.(*client.NetBoxAPI), parentType, parentID, ids)
+}
+
+// resourceIpamServiceCheckIPAddresses fails unless every IP address is
+// assigned to an interface of the parent of the service, which is either a
+// device or a virtual machine, so that a mismatch is reported at plan time.
+func resourceIpamServiceCheckIPAddresses(ctx context.Context, c *client.NetBoxAPI, parentType string, parentID int64, ids []int64) error {
+	for _, id := range ids {
+		var result struct {
+			Address        string `json:"address"`
+			AssignedObject *struct {
+				Device *struct {
+					ID int64 `json:"id"`
+				} `json:"device"`
+				VirtualMachine *struct {
+					ID int64 `json:"id"`
+				} `json:"virtual_machine"`
+			} `json:"assigned_object"`
+		}
+
+		err := netboxRequest(ctx, c, "GET", fmt.Sprintf("/ipam/ip-addresses/%d/", id), nil, &result)
+		if err != nil {
+			if isNotFound(err) {
+				return fmt.Errorf("ipaddresses: IP address %d doesn't exist", id)
+			}
+
+			return fmt.Errorf("Unable to get IP address %d: %s", id, errorDetail(err))
+		}
+
+		var assigned int64
+		if o := result.AssignedObject; o != nil {
+			switch {
+			case parentType == "device" && o.Device != nil:
+				assigned = o.Device.ID
+			case parentType == "virtual_machine" && o.VirtualMachine != nil:
+				assigned = o.VirtualMachine.ID
+			}
+		}
+
+		if assigned != parentID {
+			return fmt.Errorf("ipaddresses: IP address %d (%s) isn't assigned to %s %d", id, result.Address, parentType, parentID)
+		}
+	}
+
+	return nil
+}
+
+func resourceIpamServiceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result ipamService
+
+	err := netboxRequest(ctx, c, "POST", "/ipam/services/", resourceIpamServiceData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create service", err, resourceIpamService().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceIpamServiceRead(ctx, d, m)
+}
+
+func resourceIpamServiceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result ipamService
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/ipam/services/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get service", err, nil)
+	}
+
+	if result.Device != nil {
+		d.Set("device_id", result.Device.ID)
+	} else {
+		d.Set("device_id", nil)
+	}
+
+	if result.VirtualMachine != nil {
+		d.Set("virtual_machine_id", result.VirtualMachine.ID)
+	} else {
+		d.Set("virtual_machine_id", nil)
+	}
+
+	d.Set("name", result.Name)
+
+	if result.Protocol != nil && result.Protocol.Value != nil {
+		d.Set("protocol", result.Protocol.Value)
+	}
+
+	ports := make([]interface{}, 0, len(result.Ports))
+	for _, v := range result.Ports {
+		ports = append(ports, v)
+	}
+
+	if len(ports) == 0 && result.Port != nil {
+		ports = append(ports, *result.Port)
+	}
+
+	d.Set("ports", ports)
+
+	ipaddresses := make([]interface{}, 0, len(result.Ipaddresses))
+	for _, v := range result.Ipaddresses {
+		ipaddresses = append(ipaddresses, v.ID)
+	}
+
+	d.Set("ipaddresses", ipaddresses)
+	d.Set("description", result.Description)
+	d.Set("tags", flattenTags(result.Tags))
+	d.Set("custom_fields", flattenCustomFields(result.CustomFields))
+
+	return diags
+}
+
+func resourceIpamServiceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/ipam/services/%d/", objectID), resourceIpamServiceData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update service", err, resourceIpamService().Schema)
+	}
+
+	return resourceIpamServiceRead(ctx, d, m)
+}
+
+func resourceIpamServiceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/ipam/services/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete service", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceIpamServiceData returns the request body for creating and updating
+// services. A single port is also sent as port, which NetBox 2.9 requires and
+// later versions ignore.
+func resourceIpamServiceData(d *schema.ResourceData) map[string]interface{} {
+	ports := expandIpamServiceIDs(d.Get("ports").(*schema.Set))
+
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"protocol":    d.Get("protocol").(string),
+		"ports":       ports,
+		"ipaddresses": expandIpamServiceIDs(d.Get("ipaddresses").(*schema.Set)),
+		"description": d.Get("description").(string),
+		"tags":        requestTags(d.Get("tags").([]interface{})),
+	}
+
+	if len(ports) == 1 {
+		data["port"] = ports[0]
+	}
+
+	if v, ok := d.GetOk("device_id"); ok {
+		data["device"] = v.(int)
+	} else {
+		data["device"] = nil
+	}
+
+	if v, ok := d.GetOk("virtual_machine_id"); ok {
+		data["virtual_machine"] = v.(int)
+	} else {
+		data["virtual_machine"] = nil
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
+
+// expandIpamServiceIDs returns the sorted integers of a set of ports or IP
+// address IDs, or an empty slice so that clearing the set reaches NetBox.
+func expandIpamServiceIDs(s *schema.Set) []int64 {
+	ids := make([]int64, 0, s.Len())
+	for _, v := range s.List() {
+		ids = append(ids, int64(v.(int)))
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
@@ -0,0 +1,209 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestResourceIpamServicePorts_validation(t *testing.T) {
+	elem := resourceIpamService().Schema["ports"].Elem.(*schema.Schema)
+
+	for port, valid := range map[int]bool{0: false, 1: true, 443: true, 65535: true, 65536: false} {
+		if diags := elem.ValidateDiagFunc(port, nil); diags.HasError() == valid {
+			t.Errorf("port %d: expected valid %v, got %v", port, valid, diags)
+		}
+	}
+}
+
+func TestResourceIpamServiceCheckIPAddresses(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/ipam/ip-addresses/1/":
+			w.Write([]byte(`{"id": 1, "address": "10.0.0.1/24", "assigned_object": {"id": 5, "device": {"id": 3}}}`))
+		case "/api/ipam/ip-addresses/2/":
+			w.Write([]byte(`{"id": 2, "address": "10.0.0.2/24", "assigned_object": {"id": 6, "virtual_machine": {"id": 3}}}`))
+		case "/api/ipam/ip-addresses/3/":
+			w.Write([]byte(`{"id": 3, "address": "10.0.0.3/24", "assigned_object": null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail": "Not found."}`))
+		}
+	})
+
+	ctx := context.Background()
+
+	if err := resourceIpamServiceCheckIPAddresses(ctx, c, "device", 3, []int64{1}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := resourceIpamServiceCheckIPAddresses(ctx, c, "virtual_machine", 3, []int64{2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := map[string]struct {
+		parentType string
+		ids        []int64
+		expected   string
+	}{
+		"other parent type": {"device", []int64{1, 2}, "IP address 2 (10.0.0.2/24) isn't assigned to device 3"},
+		"unassigned":        {"virtual_machine", []int64{3}, "IP address 3 (10.0.0.3/24) isn't assigned to virtual_machine 3"},
+		"missing":           {"device", []int64{4}, "IP address 4 doesn't exist"},
+	}
+
+	for name, tt := range tests {
+		err := resourceIpamServiceCheckIPAddresses(ctx, c, tt.parentType, 3, tt.ids)
+		if err == nil || !strings.Contains(err.Error(), tt.expected) {
+			t.Errorf("%s: expected error containing %q, got %v", name, tt.expected, err)
+		}
+	}
+}
+
+func TestResourceIpamServiceCreate(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == "POST" {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+
+			if !reflect.DeepEqual(body["ports"], []interface{}{float64(53)}) || body["port"] != float64(53) {
+				t.Errorf("expected ports [53] and port 53, got %#v and %#v", body["ports"], body["port"])
+			}
+
+			if !reflect.DeepEqual(body["ipaddresses"], []interface{}{}) {
+				t.Errorf("expected empty ipaddresses, got %#v", body["ipaddresses"])
+			}
+		}
+
+		// NetBox 2.9 returns a single port.
+		w.Write([]byte(`{"id": 9, "device": {"id": 3}, "name": "dns", "protocol": {"value": "udp", "label": "UDP"}, "port": 53, "ipaddresses": [], "tags": []}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceIpamService().Schema, map[string]interface{}{
+		"device_id": 3,
+		"name":      "dns",
+		"protocol":  "udp",
+		"ports":     []interface{}{53},
+	})
+
+	if diags := resourceIpamServiceCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if ports := d.Get("ports").(*schema.Set); ports.Len() != 1 || !ports.Contains(53) {
+		t.Fatalf("unexpected ports %v", ports.List())
+	}
+}
+
+func TestAccIpamService_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpamServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIpamServiceConfigBasic(`[80, 443]`, `[netbox_ipam_ipaddress.test-service.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpamServiceExists("netbox_ipam_service.test"),
+					resource.TestCheckResourceAttrPair("netbox_ipam_service.test", "device_id", "netbox_dcim_device.test-service", "id"),
+					resource.TestCheckResourceAttr("netbox_ipam_service.test", "name", "https"),
+					resource.TestCheckResourceAttr("netbox_ipam_service.test", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("netbox_ipam_service.test", "ports.#", "2"),
+					resource.TestCheckTypeSetElemAttr("netbox_ipam_service.test", "ports.*", "443"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_ipam_service.test", "ipaddresses.*", "netbox_ipam_ipaddress.test-service", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIpamServiceConfigBasic(`[8443]`, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipam_service.test", "ports.#", "1"),
+					resource.TestCheckResourceAttr("netbox_ipam_service.test", "ipaddresses.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipam_service.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIpamServiceDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_ipam_service" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/ipam/services/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Service ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckIpamServiceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No service ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/ipam/services/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+func testAccCheckIpamServiceConfigBasic(ports, ipaddresses string) string {
+	return testAccDcimDeviceConfig("test-service") + fmt.Sprintf(`
+resource "netbox_dcim_interface" "test-service" {
+  device_id = netbox_dcim_device.test-service.id
+  type      = "virtual"
+  name      = "eth0"
+}
+
+resource "netbox_ipam_ipaddress" "test-service" {
+  address              = "10.0.22.1/24"
+  assigned_object_type = "dcim.interface"
+  assigned_object_id   = netbox_dcim_interface.test-service.id
+}
+
+resource "netbox_ipam_service" "test" {
+  device_id   = netbox_dcim_device.test-service.id
+  name        = "https"
+  protocol    = "tcp"
+  ports       = %s
+  ipaddresses = %s
+}
+`, ports, ipaddresses)
+}
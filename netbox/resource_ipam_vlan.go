@@ -4,7 +4,6 @@ import (
 	"context"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/ipam"
 	"github.com/netbox-community/go-netbox/netbox/models"
@@ -20,6 +19,10 @@ func resourceIpamVlan() *schema.Resource {
 		UpdateContext: resourceIpamVlanUpdate,
 		DeleteContext: resourceIpamVlanDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -136,7 +139,7 @@ func resourceIpamVlanCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	resp, err := c.Ipam.IpamVlansCreate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to create prefix: %v", err)
+		return apiErrorDiags("Unable to create vlan", err, resourceIpamVlan().Schema)
 	}
 
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
@@ -163,12 +166,12 @@ func resourceIpamVlanRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	resp, err := c.Ipam.IpamVlansRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get vlan: %v", err)
+		return apiErrorDiags("Unable to get vlan", err, nil)
 	}
 
 	d.Set("name", resp.Payload.Name)
@@ -249,7 +252,7 @@ func resourceIpamVlanUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	_, err = c.Ipam.IpamVlansPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update vlan: %v", err)
+		return apiErrorDiags("Unable to update vlan", err, resourceIpamVlan().Schema)
 	}
 
 	return resourceIpamVlanRead(ctx, d, m)
@@ -271,8 +274,8 @@ func resourceIpamVlanDelete(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	_, err = c.Ipam.IpamVlansDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete vlan: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete vlan", err, nil)
 	}
 
 	d.SetId("")
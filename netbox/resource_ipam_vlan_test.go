@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
@@ -28,6 +27,11 @@ func TestAccIpamVlan_basic(t *testing.T) {
 					testAccCheckIpamVlanExists("netbox_ipam_vlan.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_ipam_vlan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -52,7 +56,7 @@ func testAccCheckIpamVlanDestroy(s *terraform.State) error {
 
 		resp, err := c.Ipam.IpamVlansRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/ipam"
 	"github.com/netbox-community/go-netbox/netbox/models"
@@ -13,6 +13,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// ipamVRF decodes VRFs returned by NetBox along with their import and export
+// route targets, which were added in NetBox 2.10 and are missing from the
+// go-netbox model.
+type ipamVRF struct {
+	models.VRF
+	ImportTargets []*ipamNestedRouteTarget `json:"import_targets"`
+	ExportTargets []*ipamNestedRouteTarget `json:"export_targets"`
+}
+
+type ipamNestedRouteTarget struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// ipamWritableVRF adds the route target IDs to the request body. They are
+// only sent when set, so that VRFs keep working with NetBox 2.9. Tags are
+// shadowed as the go-netbox model drops an empty list, which is needed to
+// remove all tags.
+type ipamWritableVRF struct {
+	models.WritableVRF
+	ImportTargets *[]int64             `json:"import_targets,omitempty"`
+	ExportTargets *[]int64             `json:"export_targets,omitempty"`
+	Tags          *[]*models.NestedTag `json:"tags,omitempty"`
+}
+
 func resourceIpamVRF() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIpamVRFCreate,
@@ -20,6 +45,10 @@ func resourceIpamVRF() *schema.Resource {
 		UpdateContext: resourceIpamVRFUpdate,
 		DeleteContext: resourceIpamVRFDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -48,6 +77,22 @@ func resourceIpamVRF() *schema.Resource {
 				Optional: true,
 			},
 
+			"import_targets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+
+			"export_targets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+
 			"tags": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -94,43 +139,55 @@ func resourceIpamVRFCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 	name := d.Get("name").(string)
 
-	params := &ipam.IpamVrfsCreateParams{
-		Context: ctx,
-	}
+	tags := requestTags(d.Get("tags").([]interface{}))
 
-	params.Data = &models.WritableVRF{
-		Name: &name,
-		Tags: expandTags(d.Get("tags").([]interface{})),
+	data := &ipamWritableVRF{
+		WritableVRF: models.WritableVRF{
+			Name: &name,
+		},
+		Tags: &tags,
 	}
 
 	if v, ok := d.GetOk("description"); ok {
-		params.Data.Description = v.(string)
+		data.Description = v.(string)
 	}
 
 	if v, ok := d.GetOk("tenant_id"); ok {
 		tenantID := int64(v.(int))
-		params.Data.Tenant = &tenantID
+		data.Tenant = &tenantID
 	}
 
 	if v, ok := d.GetOk("enforce_unique"); ok {
-		params.Data.EnforceUnique = v.(bool)
+		data.EnforceUnique = v.(bool)
 	}
 
 	if v, ok := d.GetOk("rd"); ok {
 		rd := v.(string)
-		params.Data.Rd = &rd
+		data.Rd = &rd
 	}
 
 	if v, ok := d.GetOk("custom_fields"); ok {
-		params.Data.CustomFields = v.(map[string]interface{})
+		data.CustomFields = v.(map[string]interface{})
 	}
 
-	resp, err := c.Ipam.IpamVrfsCreate(params, nil)
+	if v, ok := d.GetOk("import_targets"); ok {
+		importTargets := expandIpamRouteTargetIDs(v.(*schema.Set))
+		data.ImportTargets = &importTargets
+	}
+
+	if v, ok := d.GetOk("export_targets"); ok {
+		exportTargets := expandIpamRouteTargetIDs(v.(*schema.Set))
+		data.ExportTargets = &exportTargets
+	}
+
+	var result models.VRF
+
+	err := netboxRequest(ctx, c, "POST", "/ipam/vrfs/", data, &result)
 	if err != nil {
-		return diag.Errorf("Unable to create vrf: %v", err)
+		return apiErrorDiags("Unable to create vrf", err, resourceIpamVRF().Schema)
 	}
 
-	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
+	d.SetId(strconv.FormatInt(result.ID, 10))
 
 	resourceIpamVRFRead(ctx, d, m)
 
@@ -147,38 +204,37 @@ func resourceIpamVRFRead(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.Errorf("Unable to parse ID: %v", err)
 	}
 
-	params := &ipam.IpamVrfsReadParams{
-		Context: ctx,
-		ID:      objectID,
-	}
+	var result ipamVRF
 
-	resp, err := c.Ipam.IpamVrfsRead(params, nil)
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/ipam/vrfs/%d/", objectID), nil, &result)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get vrf: %v", err)
+		return apiErrorDiags("Unable to get vrf", err, nil)
 	}
 
-	d.Set("name", resp.Payload.Name)
-	d.Set("enforce_unique", resp.Payload.EnforceUnique)
+	d.Set("name", result.Name)
+	d.Set("enforce_unique", result.EnforceUnique)
 
-	if resp.Payload.Tenant != nil {
-		d.Set("tenant_id", resp.Payload.Tenant.ID)
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
 	}
 
-	if resp.Payload.Description != "" {
-		d.Set("description", resp.Payload.Description)
+	if result.Description != "" {
+		d.Set("description", result.Description)
 	}
 
-	if resp.Payload.Rd != nil {
-		d.Set("rd", resp.Payload.Rd)
+	if result.Rd != nil {
+		d.Set("rd", result.Rd)
 	}
 
-	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("import_targets", flattenIpamRouteTargetIDs(result.ImportTargets))
+	d.Set("export_targets", flattenIpamRouteTargetIDs(result.ExportTargets))
+	d.Set("tags", flattenTags(result.Tags))
+	d.Set("custom_fields", flattenCustomFields(result.CustomFields))
 
 	return diags
 }
@@ -193,44 +249,52 @@ func resourceIpamVRFUpdate(ctx context.Context, d *schema.ResourceData, m interf
 
 	name := d.Get("name").(string)
 
-	params := &ipam.IpamVrfsPartialUpdateParams{
-		Context: ctx,
-		ID:      objectID,
-	}
-
-	params.Data = &models.WritableVRF{
-		Name: &name,
+	data := &ipamWritableVRF{
+		WritableVRF: models.WritableVRF{
+			Name: &name,
+		},
 	}
 
 	if d.HasChange("description") {
-		params.Data.Description = d.Get("description").(string)
+		data.Description = d.Get("description").(string)
 	}
 
 	if d.HasChange("tenant_id") {
 		tenantID := int64(d.Get("tenant_id").(int))
-		params.Data.Tenant = &tenantID
+		data.Tenant = &tenantID
 	}
 
 	if d.HasChange("enforce_unique") {
-		params.Data.EnforceUnique = d.Get("enforce_unique").(bool)
+		data.EnforceUnique = d.Get("enforce_unique").(bool)
 	}
 
 	if d.HasChange("rd") {
 		rd := d.Get("rd").(string)
-		params.Data.Rd = &rd
+		data.Rd = &rd
 	}
 
 	if d.HasChange("tags") {
-		params.Data.Tags = expandTags(d.Get("tags").([]interface{}))
+		tags := requestTags(d.Get("tags").([]interface{}))
+		data.Tags = &tags
 	}
 
 	if d.HasChange("custom_fields") {
-		params.Data.CustomFields = d.Get("custom_fields").(map[string]interface{})
+		data.CustomFields = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	if d.HasChange("import_targets") {
+		importTargets := expandIpamRouteTargetIDs(d.Get("import_targets").(*schema.Set))
+		data.ImportTargets = &importTargets
 	}
 
-	_, err = c.Ipam.IpamVrfsPartialUpdate(params, nil)
+	if d.HasChange("export_targets") {
+		exportTargets := expandIpamRouteTargetIDs(d.Get("export_targets").(*schema.Set))
+		data.ExportTargets = &exportTargets
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/ipam/vrfs/%d/", objectID), data, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update vrf: %v", err)
+		return apiErrorDiags("Unable to update vrf", err, resourceIpamVRF().Schema)
 	}
 
 	return resourceIpamVRFRead(ctx, d, m)
@@ -252,11 +316,33 @@ func resourceIpamVRFDelete(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	_, err = c.Ipam.IpamVrfsDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete vrf: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete vrf", err, nil)
 	}
 
 	d.SetId("")
 
 	return diags
 }
+
+// expandIpamRouteTargetIDs returns the route target IDs in set, which is
+// empty rather than nil so that clearing all targets reaches NetBox.
+func expandIpamRouteTargetIDs(set *schema.Set) []int64 {
+	ids := make([]int64, 0, set.Len())
+	for _, v := range set.List() {
+		ids = append(ids, int64(v.(int)))
+	}
+
+	return ids
+}
+
+// flattenIpamRouteTargetIDs returns the IDs of targets. They are stored in a
+// set, so the order NetBox returns them in doesn't cause a diff.
+func flattenIpamRouteTargetIDs(targets []*ipamNestedRouteTarget) []interface{} {
+	ids := make([]interface{}, 0, len(targets))
+	for _, v := range targets {
+		ids = append(ids, int(v.ID))
+	}
+
+	return ids
+}
@@ -2,17 +2,90 @@ package netbox
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
 	"strconv"
 	"testing"
 
-	"github.com/go-openapi/runtime"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/ipam"
 )
 
+func TestResourceIpamVRFCreate_routeTargets(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == "POST" {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+
+			if !reflect.DeepEqual(body["import_targets"], []interface{}{float64(3)}) {
+				t.Errorf("expected import_targets [3], got %#v", body["import_targets"])
+			}
+
+			if _, ok := body["export_targets"]; ok {
+				t.Errorf("expected export_targets to be omitted, got %#v", body["export_targets"])
+			}
+		}
+
+		w.Write([]byte(`{"id": 7, "name": "blue", "import_targets": [{"id": 3, "name": "65000:3"}], "export_targets": [], "tags": []}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceIpamVRF().Schema, map[string]interface{}{
+		"name":           "blue",
+		"import_targets": []interface{}{3},
+	})
+
+	if diags := resourceIpamVRFCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "7" {
+		t.Fatalf("expected ID 7, got %q", d.Id())
+	}
+}
+
+func TestResourceIpamVRFRead_routeTargets(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": 7,
+			"name": "blue",
+			"import_targets": [{"id": 5, "name": "65000:5"}, {"id": 3, "name": "65000:3"}],
+			"export_targets": [{"id": 3, "name": "65000:3"}],
+			"tags": []
+		}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceIpamVRF().Schema, map[string]interface{}{
+		"name":           "blue",
+		"import_targets": []interface{}{3, 5},
+		"export_targets": []interface{}{3},
+	})
+	d.SetId("7")
+
+	before := d.Get("import_targets").(*schema.Set)
+
+	if diags := resourceIpamVRFRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if after := d.Get("import_targets").(*schema.Set); !after.Equal(before) {
+		t.Fatalf("expected import_targets %v, got %v", before.List(), after.List())
+	}
+
+	if exportTargets := d.Get("export_targets").(*schema.Set); exportTargets.Len() != 1 || !exportTargets.Contains(3) {
+		t.Fatalf("unexpected export_targets %v", exportTargets.List())
+	}
+}
+
 func TestAccIpamVRF_basic(t *testing.T) {
 	name := "Test VRF"
 
@@ -27,6 +100,43 @@ func TestAccIpamVRF_basic(t *testing.T) {
 					testAccCheckIpamVRFExists("netbox_ipam_vrf.test"),
 				),
 			},
+			{
+				ResourceName:      "netbox_ipam_vrf.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIpamVRF_routeTargets(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIpamVRFDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIpamVRFConfigRouteTargets(`[netbox_ipam_route_target.test-vrf-b.id, netbox_ipam_route_target.test-vrf-a.id]`, `[netbox_ipam_route_target.test-vrf-a.id]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIpamVRFExists("netbox_ipam_vrf.test"),
+					resource.TestCheckResourceAttr("netbox_ipam_vrf.test", "import_targets.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_ipam_vrf.test", "import_targets.*", "netbox_ipam_route_target.test-vrf-a", "id"),
+					resource.TestCheckTypeSetElemAttrPair("netbox_ipam_vrf.test", "import_targets.*", "netbox_ipam_route_target.test-vrf-b", "id"),
+					resource.TestCheckResourceAttr("netbox_ipam_vrf.test", "export_targets.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIpamVRFConfigRouteTargets(`[netbox_ipam_route_target.test-vrf-a.id]`, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_ipam_vrf.test", "import_targets.#", "1"),
+					resource.TestCheckResourceAttr("netbox_ipam_vrf.test", "export_targets.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_ipam_vrf.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -51,7 +161,7 @@ func testAccCheckIpamVRFDestroy(s *terraform.State) error {
 
 		resp, err := c.Ipam.IpamVrfsRead(params, nil)
 		if err != nil {
-			if err.(*runtime.APIError).Code == 404 {
+			if isNotFound(err) {
 				return nil
 			}
 
@@ -104,3 +214,21 @@ resource "netbox_ipam_vrf" "test" {
 	}
 `, name)
 }
+
+func testAccCheckIpamVRFConfigRouteTargets(importTargets, exportTargets string) string {
+	return fmt.Sprintf(`
+resource "netbox_ipam_route_target" "test-vrf-a" {
+  name = "65000:1801"
+}
+
+resource "netbox_ipam_route_target" "test-vrf-b" {
+  name = "65000:1802"
+}
+
+resource "netbox_ipam_vrf" "test" {
+  name           = "test-vrf-route-targets"
+  import_targets = %s
+  export_targets = %s
+}
+`, importTargets, exportTargets)
+}
@@ -2,9 +2,9 @@ package netbox
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
-	"github.com/go-openapi/runtime"
 	"github.com/netbox-community/go-netbox/netbox/client"
 	"github.com/netbox-community/go-netbox/netbox/client/tenancy"
 
@@ -21,6 +21,10 @@ func resourceTenancyTenant() *schema.Resource {
 		UpdateContext: resourceTenancyTenantUpdate,
 		DeleteContext: resourceTenancyTenantDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceTenancyTenantResolveSlug),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -117,15 +121,15 @@ func resourceTenancyTenantCreate(ctx context.Context, d *schema.ResourceData, m
 		params.Data.Description = v.(string)
 	}
 
-	resp, err := c.Tenancy.TenancyTenantsCreate(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to create tenant: %v", err)
-	}
-
 	if v, ok := d.GetOk("custom_fields"); ok {
 		params.Data.CustomFields = v.(map[string]interface{})
 	}
 
+	resp, err := c.Tenancy.TenancyTenantsCreate(params, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to create tenant", err, resourceTenancyTenant().Schema)
+	}
+
 	d.SetId(strconv.FormatInt(resp.Payload.ID, 10))
 
 	resourceTenancyTenantRead(ctx, d, m)
@@ -150,12 +154,12 @@ func resourceTenancyTenantRead(ctx context.Context, d *schema.ResourceData, m in
 
 	resp, err := c.Tenancy.TenancyTenantsRead(params, nil)
 	if err != nil {
-		if err.(*runtime.APIError).Code == 404 {
+		if isNotFound(err) {
 			d.SetId("")
 			return nil
 		}
 
-		return diag.Errorf("Unable to get tenant: %v", err)
+		return apiErrorDiags("Unable to get tenant", err, nil)
 	}
 
 	d.Set("name", resp.Payload.Name)
@@ -174,7 +178,7 @@ func resourceTenancyTenantRead(ctx context.Context, d *schema.ResourceData, m in
 	}
 
 	d.Set("tags", flattenTags(resp.Payload.Tags))
-	d.Set("custom_fields", resp.Payload.CustomFields)
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
 
 	return diags
 }
@@ -223,7 +227,7 @@ func resourceTenancyTenantUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	_, err = c.Tenancy.TenancyTenantsPartialUpdate(params, nil)
 	if err != nil {
-		return diag.Errorf("Unable to update tenant: %v", err)
+		return apiErrorDiags("Unable to update tenant", err, resourceTenancyTenant().Schema)
 	}
 
 	return resourceTenancyTenantRead(ctx, d, m)
@@ -245,11 +249,30 @@ func resourceTenancyTenantDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	_, err = c.Tenancy.TenancyTenantsDelete(params, nil)
-	if err != nil {
-		return diag.Errorf("Unable to delete tenant: %v", err)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete tenant", err, nil)
 	}
 
 	d.SetId("")
 
 	return diags
 }
+
+func resourceTenancyTenantResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &tenancy.TenancyTenantsListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Tenancy.TenancyTenantsList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list tenants: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("tenant", slug, ids)
+}
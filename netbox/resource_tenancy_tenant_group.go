@@ -0,0 +1,184 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/tenancy"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceTenancyTenantGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTenancyTenantGroupCreate,
+		ReadContext:   resourceTenancyTenantGroupRead,
+		UpdateContext: resourceTenancyTenantGroupUpdate,
+		DeleteContext: resourceTenancyTenantGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceTenancyTenantGroupResolveSlug),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 50),
+			},
+
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"parent_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+		},
+	}
+}
+
+func resourceTenancyTenantGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result models.TenantGroup
+
+	err := netboxRequest(ctx, c, "POST", "/tenancy/tenant-groups/", resourceTenancyTenantGroupData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create tenant group", err, resourceTenancyTenantGroup().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceTenancyTenantGroupRead(ctx, d, m)
+}
+
+func resourceTenancyTenantGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &tenancy.TenancyTenantGroupsReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Tenancy.TenancyTenantGroupsRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get tenant group", err, nil)
+	}
+
+	d.Set("name", resp.Payload.Name)
+	d.Set("slug", resp.Payload.Slug)
+
+	if resp.Payload.Parent != nil {
+		d.Set("parent_id", resp.Payload.Parent.ID)
+	} else {
+		d.Set("parent_id", nil)
+	}
+
+	d.Set("description", resp.Payload.Description)
+
+	return diags
+}
+
+func resourceTenancyTenantGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/tenancy/tenant-groups/%d/", objectID), resourceTenancyTenantGroupData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update tenant group", err, resourceTenancyTenantGroup().Schema)
+	}
+
+	return resourceTenancyTenantGroupRead(ctx, d, m)
+}
+
+func resourceTenancyTenantGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &tenancy.TenancyTenantGroupsDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Tenancy.TenancyTenantGroupsDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete tenant group", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceTenancyTenantGroupData returns the request body for creating and
+// updating tenant groups.
+func resourceTenancyTenantGroupData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"description": d.Get("description").(string),
+	}
+
+	if v, ok := d.GetOk("parent_id"); ok {
+		data["parent"] = v.(int)
+	} else {
+		data["parent"] = nil
+	}
+
+	return data
+}
+
+func resourceTenancyTenantGroupResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &tenancy.TenancyTenantGroupsListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Tenancy.TenancyTenantGroupsList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list tenant groups: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("tenant group", slug, ids)
+}
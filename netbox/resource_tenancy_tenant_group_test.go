@@ -0,0 +1,143 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/tenancy"
+)
+
+func TestAccTenancyTenantGroup_basic(t *testing.T) {
+	name := "test tenant group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTenancyTenantGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTenancyTenantGroupConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTenancyTenantGroupExists("netbox_tenancy_tenant_group.test"),
+					resource.TestCheckResourceAttrPair("netbox_tenancy_tenant_group.test", "parent_id", "netbox_tenancy_tenant_group.test-tenant-group", "id"),
+					resource.TestCheckResourceAttr("netbox_tenancy_tenant_group.test", "description", "Acceptance test"),
+				),
+			},
+			{
+				Config: testAccCheckTenancyTenantGroupConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_tenancy_tenant_group.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_tenancy_tenant_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_tenancy_tenant_group.test",
+				ImportState:       true,
+				ImportStateId:     "test-tenant-group",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTenancyTenantGroupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_tenancy_tenant_group" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &tenancy.TenancyTenantGroupsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Tenancy.TenancyTenantGroupsRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Tenant group ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTenancyTenantGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No tenant group ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &tenancy.TenancyTenantGroupsReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Tenancy.TenancyTenantGroupsRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTenancyTenantGroupConfigBasic(name string) string {
+	return testAccTenancyTenantGroupParent + fmt.Sprintf(`
+resource "netbox_tenancy_tenant_group" "test" {
+  name        = "%s"
+  slug        = "test-tenant-group"
+  parent_id   = netbox_tenancy_tenant_group.test-tenant-group.id
+  description = "Acceptance test"
+}
+`, name)
+}
+
+func testAccCheckTenancyTenantGroupConfigUpdate(name string) string {
+	return testAccTenancyTenantGroupParent + fmt.Sprintf(`
+resource "netbox_tenancy_tenant_group" "test" {
+  name = "%s"
+  slug = "test-tenant-group"
+}
+`, name)
+}
+
+var testAccTenancyTenantGroupParent = `
+resource "netbox_tenancy_tenant_group" "test-tenant-group" {
+  name = "test-tenant-group parent"
+  slug = "test-tenant-group-parent"
+}
+`
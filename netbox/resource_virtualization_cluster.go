@@ -0,0 +1,254 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceVirtualizationCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVirtualizationClusterCreate,
+		ReadContext:   resourceVirtualizationClusterRead,
+		UpdateContext: resourceVirtualizationClusterUpdate,
+		DeleteContext: resourceVirtualizationClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceVirtualizationClusterResolveName),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 100),
+			},
+
+			"type_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"site_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualizationClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result models.Cluster
+
+	err := netboxRequest(ctx, c, "POST", "/virtualization/clusters/", resourceVirtualizationClusterData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create cluster", err, resourceVirtualizationCluster().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceVirtualizationClusterRead(ctx, d, m)
+}
+
+func resourceVirtualizationClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &virtualization.VirtualizationClustersReadParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	resp, err := c.Virtualization.VirtualizationClustersRead(params, nil)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get cluster", err, nil)
+	}
+
+	d.Set("name", resp.Payload.Name)
+	d.Set("type_id", resp.Payload.Type.ID)
+
+	if resp.Payload.Group != nil {
+		d.Set("group_id", resp.Payload.Group.ID)
+	} else {
+		d.Set("group_id", nil)
+	}
+
+	if resp.Payload.Site != nil {
+		d.Set("site_id", resp.Payload.Site.ID)
+	} else {
+		d.Set("site_id", nil)
+	}
+
+	if resp.Payload.Tenant != nil {
+		d.Set("tenant_id", resp.Payload.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	d.Set("comments", resp.Payload.Comments)
+	d.Set("tags", flattenTags(resp.Payload.Tags))
+	d.Set("custom_fields", flattenCustomFields(resp.Payload.CustomFields))
+
+	return diags
+}
+
+func resourceVirtualizationClusterUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/virtualization/clusters/%d/", objectID), resourceVirtualizationClusterData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update cluster", err, resourceVirtualizationCluster().Schema)
+	}
+
+	return resourceVirtualizationClusterRead(ctx, d, m)
+}
+
+func resourceVirtualizationClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &virtualization.VirtualizationClustersDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Virtualization.VirtualizationClustersDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete cluster", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceVirtualizationClusterData returns the request body for creating and
+// updating clusters.
+func resourceVirtualizationClusterData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":     d.Get("name").(string),
+		"type":     d.Get("type_id").(int),
+		"comments": d.Get("comments").(string),
+		"tags":     requestTags(d.Get("tags").([]interface{})),
+	}
+
+	for attribute, field := range map[string]string{
+		"group_id":  "group",
+		"site_id":   "site",
+		"tenant_id": "tenant",
+	} {
+		if v, ok := d.GetOk(attribute); ok {
+			data[field] = v.(int)
+		} else {
+			data[field] = nil
+		}
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
+
+func resourceVirtualizationClusterResolveName(ctx context.Context, c *client.NetBoxAPI, name string) (int64, error) {
+	params := &virtualization.VirtualizationClustersListParams{
+		Context: ctx,
+		Name:    &name,
+	}
+
+	resp, err := c.Virtualization.VirtualizationClustersList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list clusters: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("cluster", name, ids)
+}
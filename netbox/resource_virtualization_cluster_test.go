@@ -0,0 +1,156 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+)
+
+func TestAccVirtualizationCluster_basic(t *testing.T) {
+	name := "test cluster"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVirtualizationClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckVirtualizationClusterConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualizationClusterExists("netbox_virtualization_cluster.test"),
+					resource.TestCheckResourceAttrPair("netbox_virtualization_cluster.test", "type_id", "netbox_virtualization_cluster_type.test-cluster", "id"),
+					resource.TestCheckResourceAttrPair("netbox_virtualization_cluster.test", "group_id", "netbox_virtualization_cluster_group.test-cluster", "id"),
+					resource.TestCheckResourceAttrPair("netbox_virtualization_cluster.test", "site_id", "netbox_dcim_site.test-cluster", "id"),
+					resource.TestCheckResourceAttr("netbox_virtualization_cluster.test", "comments", "test comments"),
+				),
+			},
+			{
+				Config: testAccCheckVirtualizationClusterConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtualization_cluster.test", "comments", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_virtualization_cluster.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_virtualization_cluster.test",
+				ImportState:       true,
+				ImportStateId:     "test cluster",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualizationClusterDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_virtualization_cluster" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &virtualization.VirtualizationClustersReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Virtualization.VirtualizationClustersRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Cluster ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVirtualizationClusterExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cluster ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &virtualization.VirtualizationClustersReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Virtualization.VirtualizationClustersRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVirtualizationClusterConfigBasic(name string) string {
+	return testAccVirtualizationClusterDeps + fmt.Sprintf(`
+resource "netbox_virtualization_cluster" "test" {
+  name     = "%s"
+  type_id  = netbox_virtualization_cluster_type.test-cluster.id
+  group_id = netbox_virtualization_cluster_group.test-cluster.id
+  site_id  = netbox_dcim_site.test-cluster.id
+  comments = "test comments"
+}
+`, name)
+}
+
+func testAccCheckVirtualizationClusterConfigUpdate(name string) string {
+	return testAccVirtualizationClusterDeps + fmt.Sprintf(`
+resource "netbox_virtualization_cluster" "test" {
+  name    = "%s"
+  type_id = netbox_virtualization_cluster_type.test-cluster.id
+}
+`, name)
+}
+
+var testAccVirtualizationClusterDeps = `
+resource "netbox_virtualization_cluster_type" "test-cluster" {
+  name = "test-cluster"
+  slug = "test-cluster"
+}
+
+resource "netbox_virtualization_cluster_group" "test-cluster" {
+  name = "test-cluster"
+  slug = "test-cluster"
+}
+
+resource "netbox_dcim_site" "test-cluster" {
+  name = "test-cluster"
+  slug = "test-cluster"
+}
+`
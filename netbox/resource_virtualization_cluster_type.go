@@ -0,0 +1,208 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// virtualizationClusterType decodes cluster types returned by NetBox, including the tags and
+// custom fields the go-netbox model lacks.
+type virtualizationClusterType struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	netboxExtras
+}
+
+func resourceVirtualizationClusterType() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVirtualizationClusterTypeCreate,
+		ReadContext:   resourceVirtualizationClusterTypeRead,
+		UpdateContext: resourceVirtualizationClusterTypeUpdate,
+		DeleteContext: resourceVirtualizationClusterTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceVirtualizationClusterTypeResolveSlug),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 50),
+			},
+
+			"slug": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualizationClusterTypeCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result virtualizationClusterType
+
+	err := netboxRequest(ctx, c, "POST", "/virtualization/cluster-types/", resourceVirtualizationClusterTypeData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create cluster type", err, resourceVirtualizationClusterType().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceVirtualizationClusterTypeRead(ctx, d, m)
+}
+
+func resourceVirtualizationClusterTypeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result virtualizationClusterType
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/virtualization/cluster-types/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get cluster type", err, nil)
+	}
+
+	d.Set("name", result.Name)
+	d.Set("slug", result.Slug)
+	d.Set("description", result.Description)
+
+	return setExtras(d, "cluster types", result.netboxExtras)
+}
+
+func resourceVirtualizationClusterTypeUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/virtualization/cluster-types/%d/", objectID), resourceVirtualizationClusterTypeData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update cluster type", err, resourceVirtualizationClusterType().Schema)
+	}
+
+	return resourceVirtualizationClusterTypeRead(ctx, d, m)
+}
+
+func resourceVirtualizationClusterTypeDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "DELETE", fmt.Sprintf("/virtualization/cluster-types/%d/", objectID), nil, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete cluster type", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceVirtualizationClusterTypeData returns the request body for creating and
+// updating cluster types.
+func resourceVirtualizationClusterTypeData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"slug":        d.Get("slug").(string),
+		"description": d.Get("description").(string),
+	}
+
+	if d.HasChange("tags") {
+		data["tags"] = requestTags(d.Get("tags").([]interface{}))
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
+
+func resourceVirtualizationClusterTypeResolveSlug(ctx context.Context, c *client.NetBoxAPI, slug string) (int64, error) {
+	params := &virtualization.VirtualizationClusterTypesListParams{
+		Context: ctx,
+		Slug:    &slug,
+	}
+
+	resp, err := c.Virtualization.VirtualizationClusterTypesList(params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list cluster types: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(resp.Payload.Results))
+	for _, v := range resp.Payload.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("cluster type", slug, ids)
+}
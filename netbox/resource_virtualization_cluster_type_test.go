@@ -0,0 +1,134 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+)
+
+func TestAccVirtualizationClusterType_basic(t *testing.T) {
+	name := "test cluster type"
+	slug := "test-cluster-type"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVirtualizationClusterTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckVirtualizationClusterTypeConfigBasic(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualizationClusterTypeExists("netbox_virtualization_cluster_type.test"),
+				),
+			},
+			{
+				Config: testAccCheckVirtualizationClusterTypeConfigUpdate(name, slug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtualization_cluster_type.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_virtualization_cluster_type.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_virtualization_cluster_type.test",
+				ImportState:       true,
+				ImportStateId:     slug,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualizationClusterTypeDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_virtualization_cluster_type" {
+			continue
+		}
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &virtualization.VirtualizationClusterTypesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		resp, err := c.Virtualization.VirtualizationClusterTypesRead(params, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Cluster type ID still exists: %d", resp.Payload.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVirtualizationClusterTypeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No cluster type ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		objectID, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		params := &virtualization.VirtualizationClusterTypesReadParams{
+			Context: context.Background(),
+			ID:      objectID,
+		}
+
+		_, err = c.Virtualization.VirtualizationClusterTypesRead(params, nil)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVirtualizationClusterTypeConfigBasic(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_virtualization_cluster_type" "test" {
+  name        = "%s"
+  slug        = "%s"
+  description = "Acceptance test"
+}
+`, name, slug)
+}
+
+func testAccCheckVirtualizationClusterTypeConfigUpdate(name string, slug string) string {
+	return fmt.Sprintf(`
+resource "netbox_virtualization_cluster_type" "test" {
+  name = "%s"
+  slug = "%s"
+}
+`, name, slug)
+}
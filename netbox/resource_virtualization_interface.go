@@ -0,0 +1,316 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// virtualizationInterface decodes VM interfaces returned by NetBox, including
+// the custom fields the go-netbox model lacks.
+type virtualizationInterface struct {
+	models.VMInterface
+	CustomFields interface{} `json:"custom_fields"`
+}
+
+func resourceVirtualizationInterface() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVirtualizationInterfaceCreate,
+		ReadContext:   resourceVirtualizationInterfaceRead,
+		UpdateContext: resourceVirtualizationInterfaceUpdate,
+		DeleteContext: resourceVirtualizationInterfaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceVirtualizationInterfaceResolveName),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 64),
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"mtu": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intBetween(1, 65536),
+			},
+
+			"mac_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.WritableVMInterfaceModeAccess,
+					models.WritableVMInterfaceModeTagged,
+					models.WritableVMInterfaceModeTaggedAll,
+				}),
+			},
+
+			"untagged_vlan_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"tagged_vlan": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+
+			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringLenBetween(0, 200),
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualizationInterfaceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var result models.VMInterface
+
+	err := netboxRequest(ctx, c, "POST", "/virtualization/interfaces/", resourceVirtualizationInterfaceData(d), &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create VM interface", err, resourceVirtualizationInterface().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceVirtualizationInterfaceRead(ctx, d, m)
+}
+
+func resourceVirtualizationInterfaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result virtualizationInterface
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/virtualization/interfaces/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get VM interface", err, nil)
+	}
+
+	d.Set("virtual_machine_id", result.VirtualMachine.ID)
+	d.Set("name", result.Name)
+	d.Set("enabled", result.Enabled)
+
+	if result.Mtu != nil {
+		d.Set("mtu", result.Mtu)
+	} else {
+		d.Set("mtu", nil)
+	}
+
+	if result.MacAddress != nil {
+		d.Set("mac_address", result.MacAddress)
+	} else {
+		d.Set("mac_address", "")
+	}
+
+	if result.Mode != nil {
+		d.Set("mode", result.Mode.Value)
+	} else {
+		d.Set("mode", "")
+	}
+
+	if result.UntaggedVlan != nil {
+		d.Set("untagged_vlan_id", result.UntaggedVlan.ID)
+	} else {
+		d.Set("untagged_vlan_id", nil)
+	}
+
+	d.Set("tagged_vlan", flattenTaggedVlans(result.TaggedVlans))
+	d.Set("description", result.Description)
+
+	return setExtras(d, "VM interfaces", netboxExtras{Tags: &result.Tags, CustomFields: result.CustomFields})
+}
+
+func resourceVirtualizationInterfaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	err = netboxRequest(ctx, c, "PATCH", fmt.Sprintf("/virtualization/interfaces/%d/", objectID), resourceVirtualizationInterfaceData(d), nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update VM interface", err, resourceVirtualizationInterface().Schema)
+	}
+
+	return resourceVirtualizationInterfaceRead(ctx, d, m)
+}
+
+func resourceVirtualizationInterfaceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &virtualization.VirtualizationInterfacesDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Virtualization.VirtualizationInterfacesDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete VM interface", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceVirtualizationInterfaceData returns the request body for creating and
+// updating VM interfaces.
+func resourceVirtualizationInterfaceData(d *schema.ResourceData) map[string]interface{} {
+	taggedVlans := expandTaggedVlans(d.Get("tagged_vlan").([]interface{}))
+	if taggedVlans == nil {
+		taggedVlans = []int64{}
+	}
+
+	data := map[string]interface{}{
+		"virtual_machine": d.Get("virtual_machine_id").(int),
+		"name":            d.Get("name").(string),
+		"enabled":         d.Get("enabled").(bool),
+		"mode":            d.Get("mode").(string),
+		"tagged_vlans":    taggedVlans,
+		"description":     d.Get("description").(string),
+		"tags":            requestTags(d.Get("tags").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("mtu"); ok {
+		data["mtu"] = v.(int)
+	} else {
+		data["mtu"] = nil
+	}
+
+	if v, ok := d.GetOk("mac_address"); ok {
+		data["mac_address"] = v.(string)
+	} else {
+		data["mac_address"] = nil
+	}
+
+	if v, ok := d.GetOk("untagged_vlan_id"); ok {
+		data["untagged_vlan"] = v.(int)
+	} else {
+		data["untagged_vlan"] = nil
+	}
+
+	if d.HasChange("custom_fields") {
+		data["custom_fields"] = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	return data
+}
+
+// resourceVirtualizationInterfaceResolveName resolves keys of the form
+// "cluster-name/vm-name/interface", as virtual machine names are only unique
+// within a cluster. The interface name is what follows the second slash, as
+// interface names can contain slashes.
+func resourceVirtualizationInterfaceResolveName(ctx context.Context, c *client.NetBoxAPI, key string) (int64, error) {
+	parts := strings.SplitN(key, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return 0, fmt.Errorf("Unexpected import ID %q, expected a numeric ID or cluster-name/vm-name/interface", key)
+	}
+
+	var result struct {
+		Results []struct {
+			ID int64 `json:"id"`
+		} `json:"results"`
+	}
+
+	query := url.Values{"cluster": {parts[0]}, "virtual_machine": {parts[1]}, "name": {parts[2]}}
+
+	err := netboxRequest(ctx, c, "GET", "/virtualization/interfaces/?"+query.Encode(), nil, &result)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list VM interfaces: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(result.Results))
+	for _, v := range result.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("VM interface", key, ids)
+}
@@ -0,0 +1,160 @@
+package netbox
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestAccVirtualizationInterface_basic(t *testing.T) {
+	name := "eth0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVirtualizationInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckVirtualizationInterfaceConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualizationInterfaceExists("netbox_virtualization_interface.test"),
+					resource.TestCheckResourceAttrPair("netbox_virtualization_interface.test", "virtual_machine_id", "netbox_virtualization_virtual_machine.test-vm-interface", "id"),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "mtu", "9000"),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "mac_address", "00:11:22:33:44:55"),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "mode", "tagged"),
+					resource.TestCheckResourceAttrPair("netbox_virtualization_interface.test", "untagged_vlan_id", "netbox_ipam_vlan.test-vm-interface-100", "id"),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "tagged_vlan.#", "1"),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "description", "test description"),
+					resource.TestCheckResourceAttrPair("netbox_ipam_ipaddress.test-vm-interface", "assigned_object_id", "netbox_virtualization_interface.test", "id"),
+				),
+			},
+			{
+				Config: testAccCheckVirtualizationInterfaceConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "enabled", "true"),
+					resource.TestCheckNoResourceAttr("netbox_virtualization_interface.test", "mtu"),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "mac_address", ""),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "mode", ""),
+					resource.TestCheckNoResourceAttr("netbox_virtualization_interface.test", "untagged_vlan_id"),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "tagged_vlan.#", "0"),
+					resource.TestCheckResourceAttr("netbox_virtualization_interface.test", "description", ""),
+				),
+			},
+			{
+				ResourceName:      "netbox_virtualization_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_virtualization_interface.test",
+				ImportState:       true,
+				ImportStateId:     "test-vm-interface/test-vm-interface/" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualizationInterfaceDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_virtualization_interface" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/virtualization/interfaces/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("VM interface ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVirtualizationInterfaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VM interface ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/virtualization/interfaces/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+var testAccVirtualizationInterfaceVirtualMachine = `
+resource "netbox_virtualization_cluster_type" "test-vm-interface" {
+  name = "test-vm-interface"
+  slug = "test-vm-interface"
+}
+
+resource "netbox_virtualization_cluster" "test-vm-interface" {
+  name    = "test-vm-interface"
+  type_id = netbox_virtualization_cluster_type.test-vm-interface.id
+}
+
+resource "netbox_virtualization_virtual_machine" "test-vm-interface" {
+  name       = "test-vm-interface"
+  cluster_id = netbox_virtualization_cluster.test-vm-interface.id
+}
+
+resource "netbox_ipam_vlan" "test-vm-interface-100" {
+  name = "test-vm-interface-100"
+  vid  = 100
+}
+
+resource "netbox_ipam_vlan" "test-vm-interface-200" {
+  name = "test-vm-interface-200"
+  vid  = 200
+}
+`
+
+func testAccCheckVirtualizationInterfaceConfigBasic(name string) string {
+	return testAccVirtualizationInterfaceVirtualMachine + fmt.Sprintf(`
+resource "netbox_virtualization_interface" "test" {
+  virtual_machine_id = netbox_virtualization_virtual_machine.test-vm-interface.id
+  name               = "%s"
+  enabled            = false
+  mtu                = 9000
+  mac_address        = "00:11:22:33:44:55"
+  mode               = "tagged"
+  untagged_vlan_id   = netbox_ipam_vlan.test-vm-interface-100.id
+  tagged_vlan        = [netbox_ipam_vlan.test-vm-interface-200.id]
+  description        = "test description"
+}
+
+resource "netbox_ipam_ipaddress" "test-vm-interface" {
+  address              = "10.0.18.1/24"
+  assigned_object_type = "virtualization.vminterface"
+  assigned_object_id   = netbox_virtualization_interface.test.id
+}
+`, name)
+}
+
+func testAccCheckVirtualizationInterfaceConfigUpdate(name string) string {
+	return testAccVirtualizationInterfaceVirtualMachine + fmt.Sprintf(`
+resource "netbox_virtualization_interface" "test" {
+  virtual_machine_id = netbox_virtualization_virtual_machine.test-vm-interface.id
+  name               = "%s"
+}
+`, name)
+}
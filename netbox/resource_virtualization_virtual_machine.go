@@ -0,0 +1,528 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/netbox-community/go-netbox/netbox/client"
+	"github.com/netbox-community/go-netbox/netbox/client/virtualization"
+
+	"github.com/netbox-community/go-netbox/netbox/models"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// virtualizationVirtualMachine decodes virtual machines returned by NetBox.
+// go-netbox models local_context_data as a string and config_context as a map
+// of strings, while NetBox sends JSON documents, so both are shadowed.
+type virtualizationVirtualMachine struct {
+	models.VirtualMachineWithConfigContext
+	ConfigContext    json.RawMessage `json:"config_context"`
+	LocalContextData json.RawMessage `json:"local_context_data"`
+}
+
+// virtualizationWritableVirtualMachine sends local_context_data as a JSON
+// document rather than as a string. Tags are shadowed too, as the go-netbox
+// model drops an empty list and removing all tags would be a no-op.
+type virtualizationWritableVirtualMachine struct {
+	models.WritableVirtualMachineWithConfigContext
+	LocalContextData json.RawMessage      `json:"local_context_data,omitempty"`
+	Tags             *[]*models.NestedTag `json:"tags,omitempty"`
+}
+
+func resourceVirtualizationVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVirtualizationVirtualMachineCreate,
+		ReadContext:   resourceVirtualizationVirtualMachineRead,
+		UpdateContext: resourceVirtualizationVirtualMachineUpdate,
+		DeleteContext: resourceVirtualizationVirtualMachineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importByNaturalKey(resourceVirtualizationVirtualMachineResolveName),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringLenBetween(1, 64),
+			},
+
+			"cluster_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"site_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"role_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"tenant_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"platform_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: stringInSlice([]string{
+					models.VirtualMachineWithConfigContextStatusValueActive,
+					models.VirtualMachineWithConfigContextStatusValueDecommissioning,
+					models.VirtualMachineWithConfigContextStatusValueFailed,
+					models.VirtualMachineWithConfigContextStatusValueOffline,
+					models.VirtualMachineWithConfigContextStatusValuePlanned,
+					models.VirtualMachineWithConfigContextStatusValueStaged,
+				}),
+				Default: models.VirtualMachineWithConfigContextStatusValueActive,
+			},
+
+			"vcpus": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intBetween(0, 32767),
+			},
+
+			"memory": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intBetween(0, 2147483647),
+			},
+
+			"disk": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: intBetween(0, 2147483647),
+			},
+
+			"primary_ip4_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"primary_ip6_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"local_context_data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: isJSON,
+				StateFunc:        jsonStateFunc,
+			},
+
+			"comments": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceVirtualizationVirtualMachineCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	name := d.Get("name").(string)
+	clusterID := int64(d.Get("cluster_id").(int))
+
+	data := &virtualizationWritableVirtualMachine{}
+	data.Name = &name
+	data.Cluster = &clusterID
+	tags := requestTags(d.Get("tags").([]interface{}))
+	data.Tags = &tags
+
+	if v, ok := d.GetOk("role_id"); ok {
+		roleID := int64(v.(int))
+		data.Role = &roleID
+	}
+
+	if v, ok := d.GetOk("tenant_id"); ok {
+		tenantID := int64(v.(int))
+		data.Tenant = &tenantID
+	}
+
+	if v, ok := d.GetOk("platform_id"); ok {
+		platformID := int64(v.(int))
+		data.Platform = &platformID
+	}
+
+	if v, ok := d.GetOk("status"); ok {
+		data.Status = v.(string)
+	}
+
+	if v, ok := d.GetOk("vcpus"); ok {
+		vcpus := int64(v.(int))
+		data.Vcpus = &vcpus
+	}
+
+	if v, ok := d.GetOk("memory"); ok {
+		memory := int64(v.(int))
+		data.Memory = &memory
+	}
+
+	if v, ok := d.GetOk("disk"); ok {
+		disk := int64(v.(int))
+		data.Disk = &disk
+	}
+
+	if v, ok := d.GetOk("primary_ip4_id"); ok {
+		primaryIP4ID := int64(v.(int))
+		data.PrimaryIp4 = &primaryIP4ID
+	}
+
+	if v, ok := d.GetOk("primary_ip6_id"); ok {
+		primaryIP6ID := int64(v.(int))
+		data.PrimaryIp6 = &primaryIP6ID
+	}
+
+	if v, ok := d.GetOk("local_context_data"); ok {
+		data.LocalContextData = json.RawMessage(v.(string))
+	}
+
+	if v, ok := d.GetOk("comments"); ok {
+		data.Comments = v.(string)
+	}
+
+	if v, ok := d.GetOk("custom_fields"); ok {
+		data.CustomFields = v.(map[string]interface{})
+	}
+
+	var result virtualizationVirtualMachine
+
+	err := netboxRequest(ctx, c, "POST", "/virtualization/virtual-machines/", data, &result)
+	if err != nil {
+		return apiErrorDiags("Unable to create virtual machine", err, resourceVirtualizationVirtualMachine().Schema)
+	}
+
+	d.SetId(strconv.FormatInt(result.ID, 10))
+
+	return resourceVirtualizationVirtualMachineRead(ctx, d, m)
+}
+
+func resourceVirtualizationVirtualMachineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	var result virtualizationVirtualMachine
+
+	err = netboxRequest(ctx, c, "GET", fmt.Sprintf("/virtualization/virtual-machines/%d/", objectID), nil, &result)
+	if err != nil {
+		if isNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return apiErrorDiags("Unable to get virtual machine", err, nil)
+	}
+
+	d.Set("name", result.Name)
+
+	if result.Cluster != nil {
+		d.Set("cluster_id", result.Cluster.ID)
+	}
+
+	if result.Site != nil {
+		d.Set("site_id", result.Site.ID)
+	} else {
+		d.Set("site_id", nil)
+	}
+
+	if result.Role != nil {
+		d.Set("role_id", result.Role.ID)
+	} else {
+		d.Set("role_id", nil)
+	}
+
+	if result.Tenant != nil {
+		d.Set("tenant_id", result.Tenant.ID)
+	} else {
+		d.Set("tenant_id", nil)
+	}
+
+	if result.Platform != nil {
+		d.Set("platform_id", result.Platform.ID)
+	} else {
+		d.Set("platform_id", nil)
+	}
+
+	if result.Status != nil {
+		d.Set("status", result.Status.Value)
+	}
+
+	d.Set("vcpus", result.Vcpus)
+	d.Set("memory", result.Memory)
+	d.Set("disk", result.Disk)
+
+	if result.PrimaryIp4 != nil {
+		d.Set("primary_ip4_id", result.PrimaryIp4.ID)
+	} else {
+		d.Set("primary_ip4_id", nil)
+	}
+
+	if result.PrimaryIp6 != nil {
+		d.Set("primary_ip6_id", result.PrimaryIp6.ID)
+	} else {
+		d.Set("primary_ip6_id", nil)
+	}
+
+	localContextData, err := normalizeJSON(string(result.LocalContextData))
+	if err != nil {
+		return diag.Errorf("Unable to parse local_context_data: %v", err)
+	}
+
+	d.Set("local_context_data", localContextData)
+	d.Set("comments", result.Comments)
+	d.Set("tags", flattenTags(result.Tags))
+	d.Set("custom_fields", flattenCustomFields(result.CustomFields))
+
+	return diags
+}
+
+func resourceVirtualizationVirtualMachineUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	name := d.Get("name").(string)
+	clusterID := int64(d.Get("cluster_id").(int))
+
+	data := &virtualizationWritableVirtualMachine{}
+	data.Name = &name
+	data.Cluster = &clusterID
+
+	// The go-netbox model drops nil references and empty values, so removed
+	// attributes are cleared by a second request.
+	cleared := map[string]interface{}{}
+
+	for attribute, field := range map[string]string{
+		"role_id":        "role",
+		"tenant_id":      "tenant",
+		"platform_id":    "platform",
+		"vcpus":          "vcpus",
+		"memory":         "memory",
+		"disk":           "disk",
+		"primary_ip4_id": "primary_ip4",
+		"primary_ip6_id": "primary_ip6",
+	} {
+		if d.HasChange(attribute) && d.Get(attribute).(int) == 0 {
+			cleared[field] = nil
+		}
+	}
+
+	if d.HasChange("role_id") {
+		roleID := int64(d.Get("role_id").(int))
+		if roleID != 0 {
+			data.Role = &roleID
+		}
+	}
+
+	if d.HasChange("tenant_id") {
+		tenantID := int64(d.Get("tenant_id").(int))
+		if tenantID != 0 {
+			data.Tenant = &tenantID
+		}
+	}
+
+	if d.HasChange("platform_id") {
+		platformID := int64(d.Get("platform_id").(int))
+		if platformID != 0 {
+			data.Platform = &platformID
+		}
+	}
+
+	if d.HasChange("status") {
+		data.Status = d.Get("status").(string)
+	}
+
+	if d.HasChange("vcpus") {
+		vcpus := int64(d.Get("vcpus").(int))
+		if vcpus != 0 {
+			data.Vcpus = &vcpus
+		}
+	}
+
+	if d.HasChange("memory") {
+		memory := int64(d.Get("memory").(int))
+		if memory != 0 {
+			data.Memory = &memory
+		}
+	}
+
+	if d.HasChange("disk") {
+		disk := int64(d.Get("disk").(int))
+		if disk != 0 {
+			data.Disk = &disk
+		}
+	}
+
+	if d.HasChange("primary_ip4_id") {
+		primaryIP4ID := int64(d.Get("primary_ip4_id").(int))
+		if primaryIP4ID != 0 {
+			data.PrimaryIp4 = &primaryIP4ID
+		}
+	}
+
+	if d.HasChange("primary_ip6_id") {
+		primaryIP6ID := int64(d.Get("primary_ip6_id").(int))
+		if primaryIP6ID != 0 {
+			data.PrimaryIp6 = &primaryIP6ID
+		}
+	}
+
+	if d.HasChange("local_context_data") {
+		if v, ok := d.GetOk("local_context_data"); ok {
+			data.LocalContextData = json.RawMessage(v.(string))
+		} else {
+			cleared["local_context_data"] = nil
+		}
+	}
+
+	if d.HasChange("comments") {
+		if v := d.Get("comments").(string); v != "" {
+			data.Comments = v
+		} else {
+			cleared["comments"] = ""
+		}
+	}
+
+	if d.HasChange("tags") {
+		tags := requestTags(d.Get("tags").([]interface{}))
+		data.Tags = &tags
+	}
+
+	if d.HasChange("custom_fields") {
+		data.CustomFields = d.Get("custom_fields").(map[string]interface{})
+	}
+
+	path := fmt.Sprintf("/virtualization/virtual-machines/%d/", objectID)
+
+	err = netboxRequest(ctx, c, "PATCH", path, data, nil)
+	if err != nil {
+		return apiErrorDiags("Unable to update virtual machine", err, resourceVirtualizationVirtualMachine().Schema)
+	}
+
+	if len(cleared) > 0 {
+		err = netboxRequest(ctx, c, "PATCH", path, cleared, nil)
+		if err != nil {
+			return apiErrorDiags("Unable to update virtual machine", err, resourceVirtualizationVirtualMachine().Schema)
+		}
+	}
+
+	return resourceVirtualizationVirtualMachineRead(ctx, d, m)
+}
+
+func resourceVirtualizationVirtualMachineDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.NetBoxAPI)
+
+	var diags diag.Diagnostics
+
+	objectID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.Errorf("Unable to parse ID: %v", err)
+	}
+
+	params := &virtualization.VirtualizationVirtualMachinesDeleteParams{
+		Context: ctx,
+		ID:      objectID,
+	}
+
+	_, err = c.Virtualization.VirtualizationVirtualMachinesDelete(params, nil)
+	if err != nil && !isNotFound(err) {
+		return apiErrorDiags("Unable to delete virtual machine", err, nil)
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// resourceVirtualizationVirtualMachineResolveName resolves keys of the form
+// "cluster-name/vm-name", as virtual machine names are only unique within a
+// cluster. Virtual machines are listed through netboxRequest since go-netbox
+// can't decode those with local context data.
+func resourceVirtualizationVirtualMachineResolveName(ctx context.Context, c *client.NetBoxAPI, key string) (int64, error) {
+	cluster, name, err := splitImportKey(key, "cluster-name/vm-name")
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		Results []struct {
+			ID int64 `json:"id"`
+		} `json:"results"`
+	}
+
+	query := url.Values{"cluster": {cluster}, "name": {name}}
+
+	err = netboxRequest(ctx, c, "GET", "/virtualization/virtual-machines/?"+query.Encode(), nil, &result)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to list virtual machines: %s", errorDetail(err))
+	}
+
+	ids := make([]int64, 0, len(result.Results))
+	for _, v := range result.Results {
+		ids = append(ids, v.ID)
+	}
+
+	return singleID("virtual machine", key, ids)
+}
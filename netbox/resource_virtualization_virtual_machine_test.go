@@ -0,0 +1,237 @@
+package netbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/netbox-community/go-netbox/netbox/client"
+)
+
+func TestResourceVirtualizationVirtualMachineRead_context(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+			"id": 7,
+			"name": "vm01",
+			"status": {"value": "active", "label": "Active"},
+			"site": {"id": 2, "slug": "dc1"},
+			"cluster": {"id": 3, "name": "esx-prod"},
+			"vcpus": 2,
+			"memory": 4096,
+			"local_context_data": {"ntp": {"servers": ["10.0.0.1"]}, "dns": "10.0.0.53"},
+			"config_context": {"ntp": {"servers": ["10.0.0.1"]}, "dns": "10.0.0.53"},
+			"tags": []
+		}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceVirtualizationVirtualMachine().Schema, nil)
+	d.SetId("7")
+
+	if diags := resourceVirtualizationVirtualMachineRead(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := `{"dns":"10.0.0.53","ntp":{"servers":["10.0.0.1"]}}`
+	if v := d.Get("local_context_data").(string); v != expected {
+		t.Fatalf("expected local_context_data %s, got %s", expected, v)
+	}
+
+	if d.Get("cluster_id").(int) != 3 || d.Get("site_id").(int) != 2 || d.Get("memory").(int) != 4096 {
+		t.Fatalf("unexpected state %#v", d.State())
+	}
+}
+
+func TestResourceVirtualizationVirtualMachineCreate_localContextData(t *testing.T) {
+	c := requestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == "POST" {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+
+			if _, ok := body["local_context_data"].(map[string]interface{}); !ok {
+				t.Errorf("expected local_context_data to be sent as an object, got %#v", body["local_context_data"])
+			}
+		}
+
+		w.Write([]byte(`{"id": 7, "name": "vm01", "cluster": {"id": 3}, "local_context_data": {"dns": "10.0.0.53"}, "tags": []}`))
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceVirtualizationVirtualMachine().Schema, map[string]interface{}{
+		"name":               "vm01",
+		"cluster_id":         3,
+		"local_context_data": `{"dns": "10.0.0.53"}`,
+	})
+
+	if diags := resourceVirtualizationVirtualMachineCreate(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "7" {
+		t.Fatalf("expected ID 7, got %q", d.Id())
+	}
+}
+
+func TestAccVirtualizationVirtualMachine_basic(t *testing.T) {
+	name := "test-virtual-machine"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVirtualizationVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckVirtualizationVirtualMachineConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVirtualizationVirtualMachineExists("netbox_virtualization_virtual_machine.test"),
+					resource.TestCheckResourceAttrPair("netbox_virtualization_virtual_machine.test", "cluster_id", "netbox_virtualization_cluster.test-virtual-machine", "id"),
+					resource.TestCheckResourceAttrPair("netbox_virtualization_virtual_machine.test", "site_id", "netbox_dcim_site.test-virtual-machine", "id"),
+					resource.TestCheckResourceAttrPair("netbox_virtualization_virtual_machine.test", "role_id", "netbox_dcim_device_role.test-virtual-machine", "id"),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "status", "planned"),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "vcpus", "2"),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "memory", "4096"),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "disk", "40"),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "local_context_data", `{"dns":"10.0.0.53","ntp":{"servers":["10.0.0.1"]}}`),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckVirtualizationVirtualMachineConfigUpdate(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "status", "active"),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "vcpus", "4"),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "local_context_data", ""),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "comments", ""),
+					resource.TestCheckResourceAttr("netbox_virtualization_virtual_machine.test", "tags.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "netbox_virtualization_virtual_machine.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "netbox_virtualization_virtual_machine.test",
+				ImportState:       true,
+				ImportStateId:     "test-virtual-machine/" + name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualizationVirtualMachineDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "netbox_virtualization_virtual_machine" {
+			continue
+		}
+
+		err := netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/virtualization/virtual-machines/%s/", rs.Primary.ID), nil, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual machine ID still exists: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVirtualizationVirtualMachineExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No virtual machine ID set")
+		}
+
+		c := testAccProvider.Meta().(*client.NetBoxAPI)
+
+		return netboxRequest(context.Background(), c, "GET", fmt.Sprintf("/virtualization/virtual-machines/%s/", rs.Primary.ID), nil, nil)
+	}
+}
+
+var testAccVirtualizationVirtualMachineCluster = `
+resource "netbox_dcim_site" "test-virtual-machine" {
+  name = "test-virtual-machine"
+  slug = "test-virtual-machine"
+}
+
+resource "netbox_dcim_device_role" "test-virtual-machine" {
+  name  = "test-virtual-machine"
+  slug  = "test-virtual-machine"
+  color = "00ff00"
+}
+
+resource "netbox_virtualization_cluster_type" "test-virtual-machine" {
+  name = "test-virtual-machine"
+  slug = "test-virtual-machine"
+}
+
+resource "netbox_virtualization_cluster" "test-virtual-machine" {
+  name    = "test-virtual-machine"
+  type_id = netbox_virtualization_cluster_type.test-virtual-machine.id
+  site_id = netbox_dcim_site.test-virtual-machine.id
+}
+`
+
+func testAccCheckVirtualizationVirtualMachineConfigBasic(name string) string {
+	return testAccVirtualizationVirtualMachineCluster + fmt.Sprintf(`
+resource "netbox_extras_tag" "test-virtual-machine" {
+  name = "test-virtual-machine"
+  slug = "test-virtual-machine"
+}
+
+resource "netbox_virtualization_virtual_machine" "test" {
+  name       = "%s"
+  cluster_id = netbox_virtualization_cluster.test-virtual-machine.id
+  role_id    = netbox_dcim_device_role.test-virtual-machine.id
+  status     = "planned"
+  vcpus      = 2
+  memory     = 4096
+  disk       = 40
+  comments   = "test comments"
+
+  local_context_data = jsonencode({
+    ntp = { servers = ["10.0.0.1"] }
+    dns = "10.0.0.53"
+  })
+
+  tags {
+    name = netbox_extras_tag.test-virtual-machine.name
+    slug = netbox_extras_tag.test-virtual-machine.slug
+  }
+}
+`, name)
+}
+
+func testAccCheckVirtualizationVirtualMachineConfigUpdate(name string) string {
+	return testAccVirtualizationVirtualMachineCluster + fmt.Sprintf(`
+resource "netbox_virtualization_virtual_machine" "test" {
+  name       = "%s"
+  cluster_id = netbox_virtualization_cluster.test-virtual-machine.id
+  role_id    = netbox_dcim_device_role.test-virtual-machine.id
+  vcpus      = 4
+  memory     = 4096
+  disk       = 40
+}
+`, name)
+}
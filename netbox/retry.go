@@ -0,0 +1,204 @@
+package netbox
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryTransport retries requests that failed because NetBox, or a proxy in
+// front of it, was temporarily unable to handle them.
+//
+// Requests that are safe to repeat (everything except POST) are retried on
+// 429 and 5xx responses and on connection errors. A POST creates an object,
+// so it is only retried when NetBox cannot have processed it: the connection
+// was never established, or the server answered 429 or 503 before handling
+// the request.
+type retryTransport struct {
+	transport  http.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+func newRetryTransport(transport http.RoundTripper, maxRetries int, waitMin, waitMax time.Duration) *retryTransport {
+	if waitMax < waitMin {
+		waitMax = waitMin
+	}
+
+	return &retryTransport{
+		transport:  transport,
+		maxRetries: maxRetries,
+		waitMin:    waitMin,
+		waitMax:    waitMax,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil && req.GetBody == nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		req.GetBody = func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.transport.RoundTrip(attemptReq)
+
+		if attempt >= t.maxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		if err != nil {
+			log.Printf("[DEBUG] %s %s failed: %s, retrying in %s (%d/%d)", req.Method, req.URL, err, wait, attempt+1, t.maxRetries)
+		} else {
+			log.Printf("[DEBUG] %s %s returned %s, retrying in %s (%d/%d)", req.Method, req.URL, resp.Status, wait, attempt+1, t.maxRetries)
+			drainBody(resp)
+		}
+
+		if err := sleepContext(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+
+		var authorityErr x509.UnknownAuthorityError
+		var hostnameErr x509.HostnameError
+		var certErr x509.CertificateInvalidError
+		if errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &certErr) {
+			return false
+		}
+
+		if req.Method != http.MethodPost {
+			return true
+		}
+
+		return isDialError(err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusServiceUnavailable:
+		return true
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		return req.Method != http.MethodPost
+	}
+
+	return false
+}
+
+// isDialError reports whether err happened while establishing the connection,
+// before any part of the request was sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr.Op == "dial"
+	}
+
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > t.waitMax {
+				wait = t.waitMax
+			}
+
+			return wait
+		}
+	}
+
+	wait := float64(t.waitMin) * math.Pow(2, float64(attempt))
+	if wait > float64(t.waitMax) {
+		wait = float64(t.waitMax)
+	}
+
+	// Spread out retries from parallel resource operations so they do not
+	// hit NetBox again at the same moment. The jitter is only ever added, so
+	// that no retry comes sooner than waitMin.
+	wait += rand.Float64() * wait / 2
+	if wait > float64(t.waitMax) {
+		wait = float64(t.waitMax)
+	}
+
+	return time.Duration(wait)
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func drainBody(resp *http.Response) {
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+	resp.Body.Close()
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,334 @@
+package netbox
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport_statusCodes(t *testing.T) {
+	cases := map[string]struct {
+		method       string
+		status       int
+		wantAttempts int32
+	}{
+		"GET 503":    {method: http.MethodGet, status: http.StatusServiceUnavailable, wantAttempts: 3},
+		"GET 502":    {method: http.MethodGet, status: http.StatusBadGateway, wantAttempts: 3},
+		"GET 429":    {method: http.MethodGet, status: http.StatusTooManyRequests, wantAttempts: 3},
+		"PATCH 504":  {method: http.MethodPatch, status: http.StatusGatewayTimeout, wantAttempts: 3},
+		"DELETE 500": {method: http.MethodDelete, status: http.StatusInternalServerError, wantAttempts: 3},
+		"GET 501":    {method: http.MethodGet, status: http.StatusNotImplemented, wantAttempts: 1},
+		"GET 400":    {method: http.MethodGet, status: http.StatusBadRequest, wantAttempts: 1},
+		"GET 404":    {method: http.MethodGet, status: http.StatusNotFound, wantAttempts: 1},
+		"POST 429":   {method: http.MethodPost, status: http.StatusTooManyRequests, wantAttempts: 3},
+		"POST 503":   {method: http.MethodPost, status: http.StatusServiceUnavailable, wantAttempts: 3},
+		"POST 500":   {method: http.MethodPost, status: http.StatusInternalServerError, wantAttempts: 1},
+		"POST 502":   {method: http.MethodPost, status: http.StatusBadGateway, wantAttempts: 1},
+		"POST 504":   {method: http.MethodPost, status: http.StatusGatewayTimeout, wantAttempts: 1},
+		"POST 400":   {method: http.MethodPost, status: http.StatusBadRequest, wantAttempts: 1},
+		"PUT 503":    {method: http.MethodPut, status: http.StatusServiceUnavailable, wantAttempts: 3},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&attempts, 1)
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			req, _ := http.NewRequest(tc.method, srv.URL, nil)
+
+			resp, err := retryTestClient(2).Do(req)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.status {
+				t.Fatalf("expected status %d, got %d", tc.status, resp.StatusCode)
+			}
+
+			if got := atomic.LoadInt32(&attempts); got != tc.wantAttempts {
+				t.Fatalf("expected %d attempts, got %d", tc.wantAttempts, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_recovers(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	resp, err := retryTestClient(4).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestRetryTransport_replaysBody(t *testing.T) {
+	var attempts int32
+	payload := `{"name":"test"}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) != payload {
+			t.Errorf("attempt %d: expected body %q, got %q", atomic.LoadInt32(&attempts)+1, payload, body)
+		}
+
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	// ioutil.NopCloser hides the concrete reader type, so http.NewRequest
+	// cannot set GetBody and the transport has to buffer the body itself.
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, ioutil.NopCloser(strings.NewReader(payload)))
+
+	resp, err := retryTestClient(2).Do(req)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d", resp.StatusCode)
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestRetryTransport_retryAfter(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	start := time.Now()
+
+	client := &http.Client{
+		Transport: newRetryTransport(retryTestBaseTransport(), 1, time.Millisecond, 2*time.Second),
+	}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected Retry-After to delay the retry by 1s, retried after %s", elapsed)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestRetryTransport_connectionReset(t *testing.T) {
+	cases := map[string]struct {
+		method       string
+		wantAttempts int32
+	}{
+		"GET":  {method: http.MethodGet, wantAttempts: 2},
+		"POST": {method: http.MethodPost, wantAttempts: 1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) == 1 {
+					conn, _, err := w.(http.Hijacker).Hijack()
+					if err != nil {
+						t.Errorf("err: %s", err)
+						return
+					}
+
+					conn.(*net.TCPConn).SetLinger(0)
+					conn.Close()
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			req, _ := http.NewRequest(tc.method, srv.URL, strings.NewReader("{}"))
+
+			resp, err := retryTestClient(2).Do(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+
+			if got := atomic.LoadInt32(&attempts); got != tc.wantAttempts {
+				t.Fatalf("expected %d attempts, got %d", tc.wantAttempts, got)
+			}
+
+			if tc.wantAttempts == 1 && err == nil {
+				t.Fatal("expected the connection error to be returned")
+			}
+		})
+	}
+}
+
+func TestRetryTransport_dialError(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	var attempts int32
+
+	transport := newRetryTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return retryTestBaseTransport().RoundTrip(req)
+	}), 2, time.Millisecond, time.Millisecond)
+
+	req, _ := http.NewRequest(http.MethodPost, "http://"+addr, strings.NewReader("{}"))
+
+	if _, err := (&http.Client{Transport: transport}).Do(req); err == nil {
+		t.Fatal("expected a connection error")
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestRetryTransport_retryAfterLimited(t *testing.T) {
+	transport := newRetryTransport(retryTestBaseTransport(), 1, time.Millisecond, 5*time.Second)
+
+	resp := &http.Response{Header: http.Header{"Retry-After": {"3600"}}}
+	if wait := transport.backoff(0, resp); wait != 5*time.Second {
+		t.Fatalf("expected Retry-After to be limited to 5s, got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", "2")
+	if wait := transport.backoff(0, resp); wait != 2*time.Second {
+		t.Fatalf("expected Retry-After of 2s, got %s", wait)
+	}
+}
+
+func TestRetryTransport_backoffBounds(t *testing.T) {
+	waitMin := 100 * time.Millisecond
+	waitMax := time.Second
+	transport := newRetryTransport(retryTestBaseTransport(), 5, waitMin, waitMax)
+
+	for attempt := 0; attempt < 6; attempt++ {
+		lower := waitMin << uint(attempt)
+		if lower > waitMax {
+			lower = waitMax
+		}
+
+		for i := 0; i < 100; i++ {
+			wait := transport.backoff(attempt, nil)
+			if wait < lower || wait > waitMax {
+				t.Fatalf("attempt %d: expected a wait between %s and %s, got %s", attempt, lower, waitMax, wait)
+			}
+
+			if attempt == 0 && wait > waitMin*3/2 {
+				t.Fatalf("attempt 0: expected at most 50%% jitter on %s, got %s", waitMin, wait)
+			}
+		}
+	}
+}
+
+func TestRetryTransport_contextCanceled(t *testing.T) {
+	var attempts int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{
+		Transport: newRetryTransport(retryTestBaseTransport(), 2, time.Millisecond, time.Minute),
+		Timeout:   200 * time.Millisecond,
+	}
+
+	if _, err := client.Get(srv.URL); err == nil {
+		t.Fatal("expected the request to time out")
+	}
+
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Fatalf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if wait, ok := parseRetryAfter("3"); !ok || wait != 3*time.Second {
+		t.Fatalf("expected 3s, got %s (%t)", wait, ok)
+	}
+
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if wait, ok := parseRetryAfter(date); !ok || wait <= 0 || wait > 10*time.Second {
+		t.Fatalf("expected up to 10s, got %s (%t)", wait, ok)
+	}
+
+	for _, value := range []string{"", "-1", "soon"} {
+		if _, ok := parseRetryAfter(value); ok {
+			t.Fatalf("expected %q to be rejected", value)
+		}
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func retryTestBaseTransport() *http.Transport {
+	return &http.Transport{DisableKeepAlives: true}
+}
+
+func retryTestClient(maxRetries int) *http.Client {
+	return &http.Client{
+		Transport: newRetryTransport(retryTestBaseTransport(), maxRetries, time.Millisecond, 5*time.Millisecond),
+	}
+}
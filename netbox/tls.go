@@ -0,0 +1,77 @@
+package netbox
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func providerTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+	}
+
+	caCert, err := pemFromFileOrString(d, "ca_cert_file", "ca_cert_pem")
+	if err != nil {
+		return nil, err
+	}
+
+	if caCert != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid PEM certificates found in CA certificate")
+		}
+
+		config.RootCAs = pool
+	}
+
+	clientCert, err := pemFromFileOrString(d, "client_cert_file", "client_cert_pem")
+	if err != nil {
+		return nil, err
+	}
+
+	clientKey, err := pemFromFileOrString(d, "client_key_file", "client_key_pem")
+	if err != nil {
+		return nil, err
+	}
+
+	if clientCert != nil || clientKey != nil {
+		if clientCert == nil || clientKey == nil {
+			return nil, fmt.Errorf("both a client certificate and a client key must be set")
+		}
+
+		cert, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %s", err)
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
+
+func pemFromFileOrString(d *schema.ResourceData, fileKey, pemKey string) ([]byte, error) {
+	if v, ok := d.GetOk(fileKey); ok {
+		b, err := ioutil.ReadFile(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %s", fileKey, err)
+		}
+
+		return b, nil
+	}
+
+	if v, ok := d.GetOk(pemKey); ok {
+		return []byte(v.(string)), nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,161 @@
+package netbox
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestProviderTLSConfig_serverVerification(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	cases := map[string]struct {
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		"system roots": {
+			raw:     map[string]interface{}{},
+			wantErr: true,
+		},
+		"ca_cert_pem": {
+			raw: map[string]interface{}{
+				"ca_cert_pem": caPEM,
+			},
+		},
+		"insecure_skip_verify": {
+			raw: map[string]interface{}{
+				"insecure_skip_verify": true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+
+			config, err := providerTLSConfig(d)
+			if err != nil {
+				t.Fatalf("err: %s", err)
+			}
+
+			_, err = tlsTestClient(config).Get(srv.URL)
+			if tc.wantErr && err == nil {
+				t.Fatal("expected certificate verification to fail")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("err: %s", err)
+			}
+		})
+	}
+}
+
+func TestProviderTLSConfig_clientCertificate(t *testing.T) {
+	certPEM, keyPEM := tlsTestClientCertificate(t)
+
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	srv.StartTLS()
+	defer srv.Close()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"insecure_skip_verify": true,
+		"client_cert_pem":      certPEM,
+		"client_key_pem":       keyPEM,
+	})
+
+	config, err := providerTLSConfig(d)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	resp, err := tlsTestClient(config).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", resp.StatusCode)
+	}
+}
+
+func TestProviderTLSConfig_invalid(t *testing.T) {
+	certPEM, _ := tlsTestClientCertificate(t)
+
+	cases := map[string]map[string]interface{}{
+		"ca_cert_pem without certificates": {
+			"ca_cert_pem": "not a certificate",
+		},
+		"missing ca_cert_file": {
+			"ca_cert_file": "/nonexistent/ca.pem",
+		},
+		"client certificate without key": {
+			"client_cert_pem": certPEM,
+		},
+	}
+
+	for name, raw := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+
+			if _, err := providerTLSConfig(d); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func tlsTestClient(config *tls.Config) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{TLSClientConfig: config},
+		Timeout:   5 * time.Second,
+	}
+}
+
+func tlsTestClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM)
+}
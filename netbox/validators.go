@@ -1,7 +1,9 @@
 package netbox
 
 import (
+	"encoding/json"
 	"net"
+	"regexp"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -40,6 +42,51 @@ func stringInSlice(valid []string) schema.SchemaValidateDiagFunc {
 	}
 }
 
+func intAtLeast(min int) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, k cty.Path) diag.Diagnostics {
+		v, ok := i.(int)
+		if !ok {
+			return diag.Errorf("expected type of %s to be integer", k)
+		}
+
+		if v < min {
+			return diag.Errorf("expected %s to be at least (%d), got %d", k, min, v)
+		}
+
+		return nil
+	}
+}
+
+func intBetween(min, max int) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, k cty.Path) diag.Diagnostics {
+		v, ok := i.(int)
+		if !ok {
+			return diag.Errorf("expected type of %s to be integer", k)
+		}
+
+		if v < min || v > max {
+			return diag.Errorf("expected %s to be in the range (%d - %d), got %d", k, min, max, v)
+		}
+
+		return nil
+	}
+}
+
+func floatAtLeast(min float64) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, k cty.Path) diag.Diagnostics {
+		v, ok := i.(float64)
+		if !ok {
+			return diag.Errorf("expected type of %s to be float", k)
+		}
+
+		if v < min {
+			return diag.Errorf("expected %s to be at least (%f), got %f", k, min, v)
+		}
+
+		return nil
+	}
+}
+
 func isCIDR(i interface{}, k cty.Path) diag.Diagnostics {
 	v, ok := i.(string)
 	if !ok {
@@ -52,3 +99,54 @@ func isCIDR(i interface{}, k cty.Path) diag.Diagnostics {
 
 	return nil
 }
+
+func stringMatch(r *regexp.Regexp, message string) schema.SchemaValidateDiagFunc {
+	return func(i interface{}, k cty.Path) diag.Diagnostics {
+		v, ok := i.(string)
+		if !ok {
+			return diag.Errorf("expected type of %s to be string", k)
+		}
+
+		if !r.MatchString(v) {
+			return diag.Errorf("invalid value for %s (%s), got %s", k, message, v)
+		}
+
+		return nil
+	}
+}
+
+var hexColorRegexp = regexp.MustCompile(`^[0-9a-f]{6}$`)
+
+func isHexColor(i interface{}, k cty.Path) diag.Diagnostics {
+	return stringMatch(hexColorRegexp, "must be a six digit lowercase hex color")(i, k)
+}
+
+func isJSON(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+
+	if v == "" {
+		return nil
+	}
+
+	if !json.Valid([]byte(v)) {
+		return diag.Errorf("expected %s to contain valid JSON, got %s", k, v)
+	}
+
+	return nil
+}
+
+func isJSONObject(i interface{}, k cty.Path) diag.Diagnostics {
+	if diags := isJSON(i, k); diags.HasError() {
+		return diags
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(i.(string)), &v); err != nil || v == nil {
+		return diag.Errorf("expected %s to contain a JSON object, got %s", k, i)
+	}
+
+	return nil
+}